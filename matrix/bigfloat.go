@@ -0,0 +1,565 @@
+package matrix
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"golang.org/x/exp/constraints"
+)
+
+// BigFloatMatrix is a matrix of arbitrary precision floating point numbers.
+// Results are rounded to Precision bits of mantissa, so ill-conditioned
+// problems can be solved accurately by choosing a large enough precision.
+type BigFloatMatrix struct {
+	Dimensions Dimension
+	Precision  uint
+	Values     [][]*big.Float
+}
+
+// NewBigFloat instantiates a BigFloatMatrix with the passed precision and values.
+// The values are copied and rounded to the precision.
+func NewBigFloat(err *error, precision uint, values ...[]*big.Float) BigFloatMatrix {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return BigFloatMatrix{}
+	}
+
+	height := len(values)
+	if height < 1 {
+		*err = errors.New("cannot create a Matrix with a dimension that is less than 1")
+		return BigFloatMatrix{}
+	}
+
+	width := len(values[0])
+	if width < 1 {
+		*err = errors.New("cannot create a Matrix with a dimension that is less than 1")
+		return BigFloatMatrix{}
+	}
+
+	if precision < 1 {
+		*err = errors.New("precision must be positive")
+		return BigFloatMatrix{}
+	}
+
+	m := make([][]*big.Float, height)
+	for j, row := range values {
+		if len(row) != width {
+			*err = errors.New("cannot create a Matrix with different row lengths")
+			return BigFloatMatrix{}
+		}
+		m[j] = make([]*big.Float, width)
+		for i, v := range row {
+			m[j][i] = new(big.Float).SetPrec(precision).Set(v)
+		}
+	}
+
+	return BigFloatMatrix{
+		Dimensions: Dimension{
+			Width:  width,
+			Height: height,
+		},
+		Precision: precision,
+		Values:    m,
+	}
+}
+
+// BigFloat converts a Matrix to a BigFloatMatrix with the passed precision.
+// Floating point values must be finite.
+func (a Matrix[T]) BigFloat(err *error, precision uint) BigFloatMatrix {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return BigFloatMatrix{}
+	}
+
+	if precision < 1 {
+		*err = errors.New("precision must be positive")
+		return BigFloatMatrix{}
+	}
+
+	if !a.isFinite() {
+		*err = errors.New("cannot convert a non-finite value to a big.Float matrix")
+		return BigFloatMatrix{}
+	}
+
+	m := make([][]*big.Float, a.Dimensions.Height)
+	for j := range m {
+		m[j] = make([]*big.Float, a.Dimensions.Width)
+		for i := range m[j] {
+			v := new(big.Float).SetPrec(precision)
+			if isInteger[T]() {
+				v.SetInt(bigInt(a.Values[j][i]))
+			} else {
+				v.SetFloat64(float64(a.Values[j][i]))
+			}
+			m[j][i] = v
+		}
+	}
+
+	return BigFloatMatrix{
+		Dimensions: a.Dimensions,
+		Precision:  precision,
+		Values:     m,
+	}
+}
+
+// BigFloat converts a RationalMatrix to a BigFloatMatrix with the passed
+// precision, rounding each value to the nearest representable one.
+func (a RationalMatrix) BigFloat(err *error, precision uint) BigFloatMatrix {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return BigFloatMatrix{}
+	}
+
+	if precision < 1 {
+		*err = errors.New("precision must be positive")
+		return BigFloatMatrix{}
+	}
+
+	m := make([][]*big.Float, a.Dimensions.Height)
+	for j := range m {
+		m[j] = make([]*big.Float, a.Dimensions.Width)
+		for i := range m[j] {
+			m[j][i] = new(big.Float).SetPrec(precision).SetRat(a.Values[j][i])
+		}
+	}
+
+	return BigFloatMatrix{
+		Dimensions: a.Dimensions,
+		Precision:  precision,
+		Values:     m,
+	}
+}
+
+// FromBigFloat converts a BigFloatMatrix to a Matrix.
+// Converting to an integer type rounds to the nearest integer and reports an
+// error if a value does not fit in T. Converting to a floating point type
+// rounds to the nearest value.
+func FromBigFloat[T constraints.Integer | constraints.Float](err *error, a BigFloatMatrix) Matrix[T] {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return Matrix[T]{}
+	}
+
+	m := make([][]T, a.Dimensions.Height)
+	for j := range m {
+		m[j] = make([]T, a.Dimensions.Width)
+		for i := range m[j] {
+			v := a.Values[j][i]
+			if !isInteger[T]() {
+				f, _ := v.Float64()
+				m[j][i] = T(f)
+				continue
+			}
+
+			if v.IsInf() {
+				*err = errors.New("result overflows the matrix element type")
+				return Matrix[T]{}
+			}
+			x, ok := fromBigInt[T](roundBigFloat(v))
+			if !ok {
+				*err = errors.New("result overflows the matrix element type")
+				return Matrix[T]{}
+			}
+			m[j][i] = x
+		}
+	}
+
+	return Matrix[T]{
+		Dimensions: a.Dimensions,
+		Values:     m,
+	}
+}
+
+// MultiplyScalar multiplies the Matrix by a scalar.
+func (a BigFloatMatrix) MultiplyScalar(err *error, x *big.Float) BigFloatMatrix {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return BigFloatMatrix{}
+	}
+
+	m := a.Clone()
+	for j := 0; j < a.Dimensions.Height; j++ {
+		for i := 0; i < a.Dimensions.Width; i++ {
+			m.Values[j][i].Mul(m.Values[j][i], x)
+		}
+	}
+	return m
+}
+
+// Multiply multiplies the matrix by another matrix.
+// The height of matix B must match the width of matrix A.
+// The result has the larger precision of the two matrices.
+func (a BigFloatMatrix) Multiply(err *error, b BigFloatMatrix) BigFloatMatrix {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return BigFloatMatrix{}
+	}
+
+	// Check matrices can be multiplied.
+	if a.Dimensions.Width != b.Dimensions.Height {
+		*err = errors.New("cannot multiply matrices due to incompatible dimensions")
+		return BigFloatMatrix{}
+	}
+
+	precision := a.Precision
+	if b.Precision > precision {
+		precision = b.Precision
+	}
+
+	width := b.Dimensions.Width
+	height := a.Dimensions.Height
+	m := make([][]*big.Float, height)
+	t := new(big.Float).SetPrec(precision)
+	for j := 0; j < height; j++ {
+		m[j] = make([]*big.Float, width)
+		for x := 0; x < width; x++ {
+			sum := new(big.Float).SetPrec(precision)
+			for i := 0; i < a.Dimensions.Width; i++ {
+				sum.Add(sum, t.Mul(a.Values[j][i], b.Values[i][x]))
+			}
+			m[j][x] = sum
+		}
+	}
+
+	return BigFloatMatrix{
+		Dimensions: Dimension{
+			Width:  width,
+			Height: height,
+		},
+		Precision: precision,
+		Values:    m,
+	}
+}
+
+// Add a matrix to another one.
+// The dimensions of the matrices must match.
+// The result has the larger precision of the two matrices.
+func (a BigFloatMatrix) Add(err *error, b BigFloatMatrix) BigFloatMatrix {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return BigFloatMatrix{}
+	}
+
+	// Check matrices can be added.
+	if a.Dimensions != b.Dimensions {
+		*err = errors.New("cannot add matrices due to incompatible dimensions")
+		return BigFloatMatrix{}
+	}
+
+	m := a.withPrecision(b.Precision)
+	for j := 0; j < a.Dimensions.Height; j++ {
+		for i := 0; i < a.Dimensions.Width; i++ {
+			m.Values[j][i].Add(m.Values[j][i], b.Values[j][i])
+		}
+	}
+	return m
+}
+
+// Subtract a matrix from another one.
+// The dimensions of the matrices must match.
+// The result has the larger precision of the two matrices.
+func (a BigFloatMatrix) Subtract(err *error, b BigFloatMatrix) BigFloatMatrix {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return BigFloatMatrix{}
+	}
+
+	// Check matrices can be subtracted.
+	if a.Dimensions != b.Dimensions {
+		*err = errors.New("cannot subtract matrices due to incompatible dimensions")
+		return BigFloatMatrix{}
+	}
+
+	m := a.withPrecision(b.Precision)
+	for j := 0; j < a.Dimensions.Height; j++ {
+		for i := 0; i < a.Dimensions.Width; i++ {
+			m.Values[j][i].Sub(m.Values[j][i], b.Values[j][i])
+		}
+	}
+	return m
+}
+
+// Inverse a matrix using Gauss-Jordan elimination with partial pivoting.
+// Pivots within a few units in the last place of zero, relative to the largest
+// magnitude, are treated as zero.
+func (a BigFloatMatrix) Inverse(err *error) BigFloatMatrix {
+	// Avoid hiding previous errors.
+	if *err != nil {
+		return BigFloatMatrix{}
+	}
+
+	// Check the matrix is square.
+	if a.Dimensions.Height != a.Dimensions.Width {
+		*err = errors.New("cannot calculate the inverse of a non-square matrix")
+		return BigFloatMatrix{}
+	}
+
+	n := a.Dimensions.Width
+	i := make([][]*big.Float, n)
+	for j := 0; j < n; j++ {
+		i[j] = make([]*big.Float, n)
+		for k := 0; k < n; k++ {
+			i[j][k] = new(big.Float).SetPrec(a.Precision)
+		}
+		i[j][j].SetInt64(1)
+	}
+
+	m, ok := a.reduce(i)
+	if !ok {
+		*err = errors.New("cannot invert, matrix is singular")
+		return BigFloatMatrix{}
+	}
+	return m
+}
+
+// Solve solves A * X = B for X using Gauss-Jordan elimination with partial
+// pivoting at the precision of A. B may have any number of columns.
+func (a BigFloatMatrix) Solve(err *error, b BigFloatMatrix) BigFloatMatrix {
+	// Avoid hiding previous errors.
+	if *err != nil {
+		return BigFloatMatrix{}
+	}
+
+	// Check the system can be solved.
+	if a.Dimensions.Height != a.Dimensions.Width {
+		*err = errors.New("cannot solve a non-square system")
+		return BigFloatMatrix{}
+	}
+	if a.Dimensions.Height != b.Dimensions.Height {
+		*err = errors.New("cannot solve due to incompatible dimensions")
+		return BigFloatMatrix{}
+	}
+
+	c := b.Clone()
+	for _, row := range c.Values {
+		for _, v := range row {
+			v.SetPrec(a.Precision)
+		}
+	}
+
+	m, ok := a.reduce(c.Values)
+	if !ok {
+		*err = errors.New("cannot solve, matrix is singular")
+		return BigFloatMatrix{}
+	}
+	return m
+}
+
+// Determinant calculates the determinant of a square matrix using Gaussian
+// elimination with partial pivoting.
+func (a BigFloatMatrix) Determinant(err *error) *big.Float {
+	// Avoid hiding previous errors.
+	if *err != nil {
+		return new(big.Float)
+	}
+
+	// Check the matrix is square.
+	if a.Dimensions.Height != a.Dimensions.Width {
+		*err = errors.New("cannot calculate the determinant of a non-square matrix")
+		return new(big.Float)
+	}
+
+	n := a.Dimensions.Width
+	m := a.Clone().Values
+	det := new(big.Float).SetPrec(a.Precision).SetInt64(1)
+	f := new(big.Float).SetPrec(a.Precision)
+	t := new(big.Float).SetPrec(a.Precision)
+	for k := 0; k < n; k++ {
+		p := pivotBigFloat(m, k, k)
+		if m[p][k].Sign() == 0 {
+			return new(big.Float).SetPrec(a.Precision)
+		}
+		if p != k {
+			m[k], m[p] = m[p], m[k]
+			det.Neg(det)
+		}
+		det.Mul(det, m[k][k])
+
+		for j := k + 1; j < n; j++ {
+			if m[j][k].Sign() == 0 {
+				continue
+			}
+			f.Quo(m[j][k], m[k][k])
+			for i := k; i < n; i++ {
+				m[j][i].Sub(m[j][i], t.Mul(f, m[k][i]))
+			}
+		}
+	}
+	return det
+}
+
+// Transpose calculates the transpose of a Matrix.
+func (a BigFloatMatrix) Transpose(err *error) BigFloatMatrix {
+	values := make([][]*big.Float, a.Dimensions.Width)
+	for j := 0; j < a.Dimensions.Width; j++ {
+		values[j] = make([]*big.Float, a.Dimensions.Height)
+		for i := 0; i < a.Dimensions.Height; i++ {
+			values[j][i] = new(big.Float).Copy(a.Values[i][j])
+		}
+	}
+
+	return BigFloatMatrix{
+		Dimensions: Dimension{
+			Width:  a.Dimensions.Height,
+			Height: a.Dimensions.Width,
+		},
+		Precision: a.Precision,
+		Values:    values,
+	}
+}
+
+func (a BigFloatMatrix) Clone() BigFloatMatrix {
+	values := make([][]*big.Float, a.Dimensions.Height)
+	for j := 0; j < a.Dimensions.Height; j++ {
+		values[j] = make([]*big.Float, a.Dimensions.Width)
+		for i := 0; i < a.Dimensions.Width; i++ {
+			values[j][i] = new(big.Float).Copy(a.Values[j][i])
+		}
+	}
+
+	return BigFloatMatrix{
+		Dimensions: a.Dimensions,
+		Precision:  a.Precision,
+		Values:     values,
+	}
+}
+
+func (a BigFloatMatrix) Equal(b BigFloatMatrix) bool {
+	if a.Dimensions != b.Dimensions {
+		return false
+	}
+	for j := 0; j < a.Dimensions.Height; j++ {
+		for i := 0; i < a.Dimensions.Width; i++ {
+			if a.Values[j][i].Cmp(b.Values[j][i]) != 0 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (a BigFloatMatrix) ApproxEqual(b BigFloatMatrix, errorMargin *big.Float) bool {
+	if a.Dimensions != b.Dimensions {
+		return false
+	}
+	d := new(big.Float).SetPrec(a.Precision)
+	for j := 0; j < a.Dimensions.Height; j++ {
+		for i := 0; i < a.Dimensions.Width; i++ {
+			if d.Sub(a.Values[j][i], b.Values[j][i]).Abs(d).Cmp(errorMargin) > 0 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (a BigFloatMatrix) String() string {
+	values := make([][]string, a.Dimensions.Height)
+	for j := range values {
+		values[j] = make([]string, a.Dimensions.Width)
+		for i := range values[j] {
+			values[j][i] = a.Values[j][i].String()
+		}
+	}
+	return fmt.Sprint(values)
+}
+
+// withPrecision returns a copy of the matrix with the larger of its precision
+// and the passed one.
+func (a BigFloatMatrix) withPrecision(precision uint) BigFloatMatrix {
+	m := a.Clone()
+	if precision > m.Precision {
+		m.Precision = precision
+		for _, row := range m.Values {
+			for _, v := range row {
+				v.SetPrec(precision)
+			}
+		}
+	}
+	return m
+}
+
+// reduce reduces [A | B] to [I | X] with partial pivoting and returns X,
+// reporting false if A is singular. The values of b are overwritten.
+func (a BigFloatMatrix) reduce(b [][]*big.Float) (BigFloatMatrix, bool) {
+	n := a.Dimensions.Width
+	augmented := make([][]*big.Float, n)
+	limit := new(big.Float).SetPrec(a.Precision)
+	for j := 0; j < n; j++ {
+		augmented[j] = make([]*big.Float, 0, n+len(b[j]))
+		for i := 0; i < n; i++ {
+			v := new(big.Float).SetPrec(a.Precision).Set(a.Values[j][i])
+			if new(big.Float).Abs(v).Cmp(limit) > 0 {
+				limit.Abs(v)
+			}
+			augmented[j] = append(augmented[j], v)
+		}
+		augmented[j] = append(augmented[j], b[j]...)
+	}
+	// Treat pivots within 16 units in the last place of zero as zero.
+	limit.SetMantExp(limit, 4-int(a.Precision))
+
+	width := len(augmented[0])
+	f := new(big.Float).SetPrec(a.Precision)
+	t := new(big.Float).SetPrec(a.Precision)
+	for k := 0; k < n; k++ {
+		p := pivotBigFloat(augmented, k, k)
+		if new(big.Float).Abs(augmented[p][k]).Cmp(limit) <= 0 {
+			return BigFloatMatrix{}, false
+		}
+		augmented[k], augmented[p] = augmented[p], augmented[k]
+
+		f.Quo(big.NewFloat(1), augmented[k][k])
+		for i := k; i < width; i++ {
+			augmented[k][i].Mul(augmented[k][i], f)
+		}
+		for j := 0; j < n; j++ {
+			if j == k || augmented[j][k].Sign() == 0 {
+				continue
+			}
+			f.Set(augmented[j][k])
+			for i := k; i < width; i++ {
+				augmented[j][i].Sub(augmented[j][i], t.Mul(f, augmented[k][i]))
+			}
+		}
+	}
+
+	values := make([][]*big.Float, n)
+	for j := 0; j < n; j++ {
+		values[j] = augmented[j][n:]
+	}
+	return BigFloatMatrix{
+		Dimensions: Dimension{
+			Width:  width - n,
+			Height: n,
+		},
+		Precision: a.Precision,
+		Values:    values,
+	}, true
+}
+
+// pivotBigFloat returns the row at or below row with the largest magnitude in
+// the column.
+func pivotBigFloat(values [][]*big.Float, row, col int) int {
+	p := row
+	max := new(big.Float).Abs(values[row][col])
+	v := new(big.Float)
+	for j := row + 1; j < len(values); j++ {
+		if v.Abs(values[j][col]).Cmp(max) > 0 {
+			p = j
+			max.Set(v)
+		}
+	}
+	return p
+}
+
+// roundBigFloat rounds v to the nearest integer, rounding halves away from zero.
+func roundBigFloat(v *big.Float) *big.Int {
+	i, _ := v.Int(nil)
+	frac := new(big.Float).Sub(v, new(big.Float).SetInt(i))
+	if frac.Abs(frac).Cmp(big.NewFloat(0.5)) >= 0 {
+		i.Add(i, big.NewInt(int64(v.Sign())))
+	}
+	return i
+}
@@ -0,0 +1,182 @@
+package matrix
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+// hilbertRational returns the n×n Hilbert matrix with entries 1/(i+j+1).
+func hilbertRational(t *testing.T, n int) RationalMatrix {
+	values := make([][]*big.Rat, n)
+	for j := range values {
+		values[j] = make([]*big.Rat, n)
+		for i := range values[j] {
+			values[j][i] = big.NewRat(1, int64(i+j+1))
+		}
+	}
+	var err error
+	h := NewRational(&err, values...)
+	assert.NilError(t, err)
+	return h
+}
+
+func TestNewBigFloat(t *testing.T) {
+	var err error
+
+	a := NewBigFloat(&err, 8, []*big.Float{big.NewFloat(1.5), big.NewFloat(257)})
+	assert.NilError(t, err)
+	assert.Equal(t, a.Precision, uint(8))
+	assert.Equal(t, a.Values[0][1].Prec(), uint(8))
+	// 257 needs 9 bits and is rounded to even.
+	assert.Equal(t, a.String(), "[[1.5 256]]")
+
+	_ = NewBigFloat(&err, 0, []*big.Float{big.NewFloat(1)})
+	assert.ErrorContains(t, err, "precision must be positive")
+	err = nil
+
+	_ = NewBigFloat(&err, 64, []*big.Float{big.NewFloat(1)}, []*big.Float{})
+	assert.ErrorContains(t, err, "cannot create a Matrix with different row lengths")
+}
+
+func TestBigFloatConversion(t *testing.T) {
+	var err error
+
+	a := New(&err, []int64{math.MaxInt64, -3}, []int64{7, 0})
+	assert.NilError(t, err)
+	b := a.BigFloat(&err, 128)
+	assert.NilError(t, err)
+	c := FromBigFloat[int64](&err, b)
+	assert.NilError(t, err)
+	assert.Check(t, c.Equal(a))
+
+	d := FromBigFloat[int8](&err, b)
+	assert.ErrorContains(t, err, "result overflows the matrix element type")
+	assert.Check(t, d.Equal(Matrix[int8]{}))
+	err = nil
+
+	e := NewBigFloat(&err, 64, []*big.Float{big.NewFloat(2.5), big.NewFloat(-2.5), big.NewFloat(0.25)})
+	assert.NilError(t, err)
+	f := FromBigFloat[int](&err, e)
+	assert.NilError(t, err)
+	assert.Equal(t, f.String(), "[[3 -3 0]]")
+	g := FromBigFloat[float64](&err, e)
+	assert.NilError(t, err)
+	assert.Equal(t, g.String(), "[[2.5 -2.5 0.25]]")
+
+	for _, v := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		h := New(&err, []float64{1, v})
+		assert.NilError(t, err)
+		m := h.BigFloat(&err, 64)
+		assert.ErrorContains(t, err, "cannot convert a non-finite value to a big.Float matrix")
+		assert.Check(t, m.Equal(BigFloatMatrix{}))
+		err = nil
+	}
+
+	_ = New(&err, []int{1}).BigFloat(&err, 0)
+	assert.ErrorContains(t, err, "precision must be positive")
+}
+
+func TestBigFloatArithmetic(t *testing.T) {
+	var err error
+
+	a := New(&err, []float64{1, 2}, []float64{3, 4}).BigFloat(&err, 64)
+	assert.NilError(t, err)
+	b := New(&err, []float64{5, 6}, []float64{7, 8}).BigFloat(&err, 128)
+	assert.NilError(t, err)
+
+	m := a.Multiply(&err, b)
+	assert.NilError(t, err)
+	assert.Equal(t, m.String(), "[[19 22] [43 50]]")
+	assert.Equal(t, m.Precision, uint(128))
+
+	m = a.Add(&err, b)
+	assert.NilError(t, err)
+	assert.Equal(t, m.String(), "[[6 8] [10 12]]")
+	assert.Equal(t, m.Values[0][0].Prec(), uint(128))
+
+	m = a.Subtract(&err, b)
+	assert.NilError(t, err)
+	assert.Equal(t, m.String(), "[[-4 -4] [-4 -4]]")
+
+	m = a.MultiplyScalar(&err, big.NewFloat(0.5))
+	assert.NilError(t, err)
+	assert.Equal(t, m.String(), "[[0.5 1] [1.5 2]]")
+
+	m = a.Transpose(&err)
+	assert.NilError(t, err)
+	assert.Equal(t, m.String(), "[[1 3] [2 4]]")
+
+	d := a.Determinant(&err)
+	assert.NilError(t, err)
+	assert.Equal(t, d.String(), "-2")
+
+	c := New(&err, []float64{1, 2, 3}).BigFloat(&err, 64)
+	assert.NilError(t, err)
+	_ = c.Multiply(&err, a)
+	assert.ErrorContains(t, err, "cannot multiply matrices due to incompatible dimensions")
+	err = nil
+
+	_ = c.Add(&err, a)
+	assert.ErrorContains(t, err, "cannot add matrices due to incompatible dimensions")
+}
+
+func TestBigFloatInverse(t *testing.T) {
+	var err error
+
+	// The 12×12 Hilbert matrix has a condition number around 1e16, beyond the
+	// reach of float64.
+	exact := hilbertRational(t, 12).Inverse(&err)
+	assert.NilError(t, err)
+
+	h := hilbertRational(t, 12).BigFloat(&err, 256)
+	assert.NilError(t, err)
+	inv := h.Inverse(&err)
+	assert.NilError(t, err)
+
+	// The entries of the exact inverse are integers up to about 1e16.
+	margin := new(big.Float).SetMantExp(big.NewFloat(1), -100)
+	assert.Check(t, inv.ApproxEqual(exact.BigFloat(&err, 256), margin))
+	assert.NilError(t, err)
+
+	a := New(&err, []float64{1, 2}, []float64{2, 4}).BigFloat(&err, 64)
+	assert.NilError(t, err)
+	_ = a.Inverse(&err)
+	assert.ErrorContains(t, err, "cannot invert, matrix is singular")
+	err = nil
+
+	d := a.Determinant(&err)
+	assert.NilError(t, err)
+	assert.Equal(t, d.Sign(), 0)
+}
+
+func TestBigFloatSolve(t *testing.T) {
+	var err error
+
+	// Vandermonde system with nodes 1..10 and a solution of ones
+	const n = 10
+	v := NewZero[float64](&err, Dimension{Width: n, Height: n})
+	b := NewZero[float64](&err, Dimension{Width: 1, Height: n})
+	assert.NilError(t, err)
+	for j := 0; j < n; j++ {
+		for i := 0; i < n; i++ {
+			v.Values[j][i] = math.Pow(float64(j+1), float64(i))
+			b.Values[j][0] += v.Values[j][i]
+		}
+	}
+
+	x := v.BigFloat(&err, 200).Solve(&err, b.BigFloat(&err, 200))
+	assert.NilError(t, err)
+	ones := NewZero[float64](&err, Dimension{Width: 1, Height: n}).BigFloat(&err, 200)
+	assert.NilError(t, err)
+	for j := range ones.Values {
+		ones.Values[j][0].SetInt64(1)
+	}
+	margin := new(big.Float).SetMantExp(big.NewFloat(1), -150)
+	assert.Check(t, x.ApproxEqual(ones, margin))
+
+	_ = v.BigFloat(&err, 64).Solve(&err, New(&err, []float64{1}).BigFloat(&err, 64))
+	assert.ErrorContains(t, err, "cannot solve due to incompatible dimensions")
+}
@@ -0,0 +1,344 @@
+package matrix
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+
+	"golang.org/x/exp/constraints"
+)
+
+// BinaryMatrix is a matrix over GF(2), the integers modulo 2, with each row
+// packed into 64 bit words. Addition is XOR and multiplication is AND.
+// https://en.wikipedia.org/wiki/GF(2)
+type BinaryMatrix struct {
+	Dimensions Dimension
+	// Values holds the rows of the matrix, with column i of a row stored in bit
+	// i%64 of word i/64. Bits beyond the width of the matrix are always zero.
+	Values [][]uint64
+}
+
+// NewBinary instantiates a BinaryMatrix with the passed values, which must be 0 or 1.
+func NewBinary(err *error, values ...[]uint8) BinaryMatrix {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return BinaryMatrix{}
+	}
+
+	height := len(values)
+	if height < 1 {
+		*err = errors.New("cannot create a Matrix with a dimension that is less than 1")
+		return BinaryMatrix{}
+	}
+
+	width := len(values[0])
+	if width < 1 {
+		*err = errors.New("cannot create a Matrix with a dimension that is less than 1")
+		return BinaryMatrix{}
+	}
+
+	m := newBinary(height, width)
+	for j, row := range values {
+		if len(row) != width {
+			*err = errors.New("cannot create a Matrix with different row lengths")
+			return BinaryMatrix{}
+		}
+		for i, v := range row {
+			if v > 1 {
+				*err = errors.New("cannot create a binary Matrix with values other than 0 and 1")
+				return BinaryMatrix{}
+			}
+			m.Values[j][i/64] |= uint64(v) << (i % 64)
+		}
+	}
+	return m
+}
+
+// NewBinaryZero instantiates a zero binary matrix of the specified dimensions.
+func NewBinaryZero(err *error, dim Dimension) BinaryMatrix {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return BinaryMatrix{}
+	}
+
+	if dim.Width < 1 || dim.Height < 1 {
+		*err = errors.New("cannot create a Matrix with a dimension that is less than 1")
+		return BinaryMatrix{}
+	}
+
+	return newBinary(dim.Height, dim.Width)
+}
+
+// Binary converts an integer Matrix to a BinaryMatrix by reducing its values modulo 2.
+func (a Matrix[T]) Binary(err *error) BinaryMatrix {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return BinaryMatrix{}
+	}
+
+	m := newBinary(a.Dimensions.Height, a.Dimensions.Width)
+	for j := 0; j < a.Dimensions.Height; j++ {
+		for i := 0; i < a.Dimensions.Width; i++ {
+			if isInteger[T]() {
+				m.Set(j, i, uint8(bigInt(a.Values[j][i]).Bit(0)))
+				continue
+			}
+			v := float64(a.Values[j][i])
+			if math.IsInf(v, 0) || math.IsNaN(v) || v != math.Trunc(v) {
+				*err = errors.New("cannot reduce a non-integer value modulo 2")
+				return BinaryMatrix{}
+			}
+			if math.Mod(v, 2) != 0 {
+				m.Set(j, i, 1)
+			}
+		}
+	}
+	return m
+}
+
+// FromBinary converts a BinaryMatrix to a Matrix of zeros and ones.
+func FromBinary[T constraints.Integer | constraints.Float](err *error, a BinaryMatrix) Matrix[T] {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return Matrix[T]{}
+	}
+
+	m := make([][]T, a.Dimensions.Height)
+	for j := range m {
+		m[j] = make([]T, a.Dimensions.Width)
+		for i := range m[j] {
+			m[j][i] = T(a.At(j, i))
+		}
+	}
+
+	return Matrix[T]{
+		Dimensions: a.Dimensions,
+		Values:     m,
+	}
+}
+
+// At returns the value in the given row and column.
+func (a BinaryMatrix) At(row, col int) uint8 {
+	return uint8(a.Values[row][col/64] >> (col % 64) & 1)
+}
+
+// Set sets the value in the given row and column to v modulo 2.
+func (a BinaryMatrix) Set(row, col int, v uint8) {
+	mask := uint64(1) << (col % 64)
+	if v&1 == 1 {
+		a.Values[row][col/64] |= mask
+	} else {
+		a.Values[row][col/64] &^= mask
+	}
+}
+
+// Multiply multiplies the matrix by another matrix.
+// The height of matix B must match the width of matrix A.
+func (a BinaryMatrix) Multiply(err *error, b BinaryMatrix) BinaryMatrix {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return BinaryMatrix{}
+	}
+
+	// Check matrices can be multiplied.
+	if a.Dimensions.Width != b.Dimensions.Height {
+		*err = errors.New("cannot multiply matrices due to incompatible dimensions")
+		return BinaryMatrix{}
+	}
+
+	// Each row of the result is the XOR of the rows of B selected by the set
+	// bits in the corresponding row of A.
+	m := newBinary(a.Dimensions.Height, b.Dimensions.Width)
+	for j, row := range a.Values {
+		for w, word := range row {
+			for word != 0 {
+				i := w*64 + bits.TrailingZeros64(word)
+				xorWords(m.Values[j], b.Values[i])
+				word &= word - 1
+			}
+		}
+	}
+	return m
+}
+
+// Add a matrix to another one, which is the XOR of their values.
+// Subtraction over GF(2) is the same operation.
+// The dimensions of the matrices must match.
+func (a BinaryMatrix) Add(err *error, b BinaryMatrix) BinaryMatrix {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return BinaryMatrix{}
+	}
+
+	// Check matrices can be added.
+	if a.Dimensions != b.Dimensions {
+		*err = errors.New("cannot add matrices due to incompatible dimensions")
+		return BinaryMatrix{}
+	}
+
+	m := a.Clone()
+	for j := range m.Values {
+		xorWords(m.Values[j], b.Values[j])
+	}
+	return m
+}
+
+// Transpose calculates the transpose of a Matrix.
+func (a BinaryMatrix) Transpose(err *error) BinaryMatrix {
+	m := newBinary(a.Dimensions.Width, a.Dimensions.Height)
+	for j, row := range a.Values {
+		for w, word := range row {
+			for word != 0 {
+				i := w*64 + bits.TrailingZeros64(word)
+				m.Values[i][j/64] |= 1 << (j % 64)
+				word &= word - 1
+			}
+		}
+	}
+	return m
+}
+
+// RREF calculates the reduced row echelon form of a matrix using Gaussian
+// elimination over GF(2).
+func (a BinaryMatrix) RREF(err *error) BinaryMatrix {
+	// Avoid hiding previous errors.
+	if *err != nil {
+		return BinaryMatrix{}
+	}
+
+	m := a.Clone()
+	rrefBinary(m.Values, a.Dimensions.Width)
+	return m
+}
+
+// Rank calculates the number of linearly independent rows of a matrix over GF(2).
+func (a BinaryMatrix) Rank(err *error) int {
+	// Avoid hiding previous errors.
+	if *err != nil {
+		return 0
+	}
+
+	return len(rrefBinary(a.Clone().Values, a.Dimensions.Width))
+}
+
+// NullSpace calculates a basis for the null space of a matrix over GF(2),
+// returning the basis vectors as the columns of the result. For a parity-check
+// matrix these are the basis codewords of the code. If the null space is
+// trivial, the result has no columns.
+func (a BinaryMatrix) NullSpace(err *error) BinaryMatrix {
+	// Avoid hiding previous errors.
+	if *err != nil {
+		return BinaryMatrix{}
+	}
+
+	n := a.Dimensions.Width
+	values := a.Clone().Values
+	pivots := rrefBinary(values, n)
+	free := freeColumns(pivots, n)
+
+	m := newBinary(n, len(free))
+	for k, f := range free {
+		m.Set(f, k, 1)
+		for j, p := range pivots {
+			m.Set(p, k, uint8(values[j][f/64]>>(f%64)&1))
+		}
+	}
+	return m
+}
+
+func (a BinaryMatrix) Clone() BinaryMatrix {
+	values := make([][]uint64, a.Dimensions.Height)
+	for j := 0; j < a.Dimensions.Height; j++ {
+		values[j] = make([]uint64, len(a.Values[j]))
+		copy(values[j], a.Values[j])
+	}
+
+	return BinaryMatrix{
+		Dimensions: a.Dimensions,
+		Values:     values,
+	}
+}
+
+func (a BinaryMatrix) Equal(b BinaryMatrix) bool {
+	if a.Dimensions != b.Dimensions {
+		return false
+	}
+	for j := range a.Values {
+		for w := range a.Values[j] {
+			if a.Values[j][w] != b.Values[j][w] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (a BinaryMatrix) String() string {
+	values := make([][]uint8, a.Dimensions.Height)
+	for j := range values {
+		values[j] = make([]uint8, a.Dimensions.Width)
+		for i := range values[j] {
+			values[j][i] = a.At(j, i)
+		}
+	}
+	return fmt.Sprint(values)
+}
+
+// newBinary allocates a zero binary matrix, allowing a width of zero.
+func newBinary(height, width int) BinaryMatrix {
+	words := (width + 63) / 64
+	values := make([][]uint64, height)
+	for j := range values {
+		values[j] = make([]uint64, words)
+	}
+
+	return BinaryMatrix{
+		Dimensions: Dimension{
+			Width:  width,
+			Height: height,
+		},
+		Values: values,
+	}
+}
+
+// rrefBinary reduces the packed rows of a matrix with n columns to reduced row
+// echelon form over GF(2) in place, returning the pivot columns.
+func rrefBinary(values [][]uint64, n int) []int {
+	m := len(values)
+	pivots := []int{}
+
+	row := 0
+	for col := 0; col < n && row < m; col++ {
+		w, mask := col/64, uint64(1)<<(col%64)
+		r := -1
+		for j := row; j < m; j++ {
+			if values[j][w]&mask != 0 {
+				r = j
+				break
+			}
+		}
+		if r < 0 {
+			continue
+		}
+		values[row], values[r] = values[r], values[row]
+
+		// Words before w are zero in the pivot row, so they can be skipped.
+		for j := 0; j < m; j++ {
+			if j != row && values[j][w]&mask != 0 {
+				xorWords(values[j][w:], values[row][w:])
+			}
+		}
+
+		pivots = append(pivots, col)
+		row++
+	}
+	return pivots
+}
+
+// xorWords sets dst to dst XOR src.
+func xorWords(dst, src []uint64) {
+	for w := range dst {
+		dst[w] ^= src[w]
+	}
+}
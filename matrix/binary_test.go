@@ -0,0 +1,171 @@
+package matrix
+
+import (
+	"math"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestNewBinary(t *testing.T) {
+	var err error
+
+	a := NewBinary(&err, []uint8{1, 0, 1}, []uint8{0, 1, 1})
+	assert.NilError(t, err)
+	assert.Equal(t, a.String(), "[[1 0 1] [0 1 1]]")
+	assert.Equal(t, a.At(0, 2), uint8(1))
+	assert.Equal(t, a.At(1, 0), uint8(0))
+
+	a.Set(1, 0, 1)
+	a.Set(0, 2, 0)
+	assert.Equal(t, a.String(), "[[1 0 0] [1 1 1]]")
+
+	z := NewBinaryZero(&err, Dimension{Width: 130, Height: 2})
+	assert.NilError(t, err)
+	assert.Equal(t, len(z.Values[0]), 3)
+
+	_ = NewBinary(&err, []uint8{1, 2})
+	assert.ErrorContains(t, err, "cannot create a binary Matrix with values other than 0 and 1")
+	err = nil
+
+	_ = NewBinary(&err, []uint8{1}, []uint8{1, 0})
+	assert.ErrorContains(t, err, "cannot create a Matrix with different row lengths")
+	err = nil
+
+	_ = NewBinaryZero(&err, Dimension{Width: 0, Height: 1})
+	assert.ErrorContains(t, err, "cannot create a Matrix with a dimension that is less than 1")
+}
+
+func TestBinaryConversion(t *testing.T) {
+	var err error
+
+	a := New(&err, []int{-1, 2}, []int{3, 4})
+	assert.NilError(t, err)
+	b := a.Binary(&err)
+	assert.NilError(t, err)
+	assert.Equal(t, b.String(), "[[1 0] [1 0]]")
+
+	c := FromBinary[float64](&err, b)
+	assert.NilError(t, err)
+	r := New(&err, []float64{1, 0}, []float64{1, 0})
+	assert.NilError(t, err)
+	assert.Check(t, c.Equal(r))
+
+	d := New(&err, []float64{0.5})
+	assert.NilError(t, err)
+	_ = d.Binary(&err)
+	assert.ErrorContains(t, err, "cannot reduce a non-integer value modulo 2")
+	err = nil
+
+	e := New(&err, []float64{math.Inf(1)})
+	assert.NilError(t, err)
+	_ = e.Binary(&err)
+	assert.ErrorContains(t, err, "cannot reduce a non-integer value modulo 2")
+}
+
+func TestBinaryArithmetic(t *testing.T) {
+	var err error
+
+	a := NewBinary(&err, []uint8{1, 1}, []uint8{0, 1})
+	assert.NilError(t, err)
+	b := NewBinary(&err, []uint8{1, 0}, []uint8{1, 1})
+	assert.NilError(t, err)
+
+	m := a.Add(&err, b)
+	assert.NilError(t, err)
+	assert.Equal(t, m.String(), "[[0 1] [1 0]]")
+
+	m = a.Multiply(&err, b)
+	assert.NilError(t, err)
+	assert.Equal(t, m.String(), "[[0 1] [1 1]]")
+
+	m = a.Transpose(&err)
+	assert.NilError(t, err)
+	assert.Equal(t, m.String(), "[[1 0] [1 1]]")
+
+	c := NewBinary(&err, []uint8{1, 0, 1})
+	assert.NilError(t, err)
+	_ = c.Multiply(&err, a)
+	assert.ErrorContains(t, err, "cannot multiply matrices due to incompatible dimensions")
+	err = nil
+
+	_ = c.Add(&err, a)
+	assert.ErrorContains(t, err, "cannot add matrices due to incompatible dimensions")
+}
+
+func TestBinaryMultiplyWide(t *testing.T) {
+	var err error
+
+	// Compare against integer multiplication reduced modulo 2 across word boundaries.
+	const n = 150
+	x := NewZero[int](&err, Dimension{Width: n, Height: 3})
+	y := NewZero[int](&err, Dimension{Width: 2, Height: n})
+	assert.NilError(t, err)
+	for i := 0; i < n; i++ {
+		x.Values[0][i] = i % 2
+		x.Values[1][i] = (i / 3) % 2
+		x.Values[2][i] = i % 7 % 2
+		y.Values[i][0] = (i / 5) % 2
+		y.Values[i][1] = i % 3 % 2
+	}
+
+	m := x.Binary(&err).Multiply(&err, y.Binary(&err))
+	assert.NilError(t, err)
+	r := x.Multiply(&err, y).Binary(&err)
+	assert.NilError(t, err)
+	assert.Check(t, m.Equal(r))
+
+	m = x.Binary(&err).Transpose(&err)
+	assert.NilError(t, err)
+	r = x.Transpose(&err).Binary(&err)
+	assert.NilError(t, err)
+	assert.Check(t, m.Equal(r))
+}
+
+func TestBinaryRREF(t *testing.T) {
+	var err error
+
+	a := NewBinary(&err, []uint8{1, 1, 0}, []uint8{0, 1, 1}, []uint8{1, 0, 1})
+	assert.NilError(t, err)
+	m := a.RREF(&err)
+	assert.NilError(t, err)
+	assert.Equal(t, m.String(), "[[1 0 1] [0 1 1] [0 0 0]]")
+	assert.Equal(t, a.Rank(&err), 2)
+	assert.NilError(t, err)
+
+	i := NewBinary(&err, []uint8{0, 1}, []uint8{1, 0})
+	assert.NilError(t, err)
+	assert.Equal(t, i.Rank(&err), 2)
+	assert.NilError(t, err)
+}
+
+func TestBinaryNullSpace(t *testing.T) {
+	var err error
+
+	// Parity-check matrix of the [7,4] Hamming code
+	h := NewBinary(&err,
+		[]uint8{1, 0, 1, 0, 1, 0, 1},
+		[]uint8{0, 1, 1, 0, 0, 1, 1},
+		[]uint8{0, 0, 0, 1, 1, 1, 1},
+	)
+	assert.NilError(t, err)
+	assert.Equal(t, h.Rank(&err), 3)
+	assert.NilError(t, err)
+
+	g := h.NullSpace(&err)
+	assert.NilError(t, err)
+	assert.Equal(t, g.Dimensions, Dimension{Width: 4, Height: 7})
+	assert.Equal(t, g.Rank(&err), 4)
+	assert.NilError(t, err)
+
+	// Every codeword satisfies the parity checks.
+	m := h.Multiply(&err, g)
+	assert.NilError(t, err)
+	assert.Check(t, m.Equal(newBinary(3, 4)))
+
+	i := NewBinary(&err, []uint8{1, 0}, []uint8{0, 1})
+	assert.NilError(t, err)
+	m = i.NullSpace(&err)
+	assert.NilError(t, err)
+	assert.Equal(t, m.Dimensions, Dimension{Width: 0, Height: 2})
+}
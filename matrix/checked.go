@@ -0,0 +1,246 @@
+package matrix
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"golang.org/x/exp/constraints"
+)
+
+// CheckedAdd adds a matrix to another one, reporting an error naming the first
+// cell whose sum overflows T instead of wrapping around.
+// Floating point matrices cannot wrap, so they are added as with Add.
+// The dimensions of the matrices must match.
+func (a Matrix[T]) CheckedAdd(err *error, b Matrix[T]) Matrix[T] {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return Matrix[T]{}
+	}
+
+	if !isInteger[T]() {
+		return a.Add(err, b)
+	}
+
+	// Check matrices can be added.
+	if a.Dimensions != b.Dimensions {
+		*err = errors.New("cannot add matrices due to incompatible dimensions")
+		return Matrix[T]{}
+	}
+
+	return elementwise(err, a, b, func(x, y T) (T, bool) {
+		return addOverflows(x, y)
+	})
+}
+
+// CheckedSubtract subtracts a matrix from another one, reporting an error naming
+// the first cell whose difference overflows T instead of wrapping around.
+// Floating point matrices cannot wrap, so they are subtracted as with Subtract.
+// The dimensions of the matrices must match.
+func (a Matrix[T]) CheckedSubtract(err *error, b Matrix[T]) Matrix[T] {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return Matrix[T]{}
+	}
+
+	if !isInteger[T]() {
+		return a.Subtract(err, b)
+	}
+
+	// Check matrices can be subtracted.
+	if a.Dimensions != b.Dimensions {
+		*err = errors.New("cannot subtract matrices due to incompatible dimensions")
+		return Matrix[T]{}
+	}
+
+	return elementwise(err, a, b, func(x, y T) (T, bool) {
+		return subtractOverflows(x, y)
+	})
+}
+
+// CheckedMultiply multiplies the matrix by another matrix, reporting an error
+// naming the first cell whose value overflows T instead of wrapping around.
+// Each cell is accumulated exactly, so only the final value must fit in T.
+// Floating point matrices cannot wrap, so they are multiplied as with Multiply.
+// The height of matix B must match the width of matrix A.
+func (a Matrix[T]) CheckedMultiply(err *error, b Matrix[T]) Matrix[T] {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return Matrix[T]{}
+	}
+
+	if !isInteger[T]() {
+		return a.Multiply(err, b)
+	}
+
+	return multiplyExact(err, a, b, func(v *big.Int) (T, bool) {
+		x, ok := fromBigInt[T](v)
+		return x, !ok
+	})
+}
+
+// SaturatingAdd adds a matrix to another one, clamping sums that overflow T to
+// the largest or smallest value of T.
+// Floating point matrices are added as with Add.
+// The dimensions of the matrices must match.
+func (a Matrix[T]) SaturatingAdd(err *error, b Matrix[T]) Matrix[T] {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return Matrix[T]{}
+	}
+
+	if !isInteger[T]() {
+		return a.Add(err, b)
+	}
+
+	// Check matrices can be added.
+	if a.Dimensions != b.Dimensions {
+		*err = errors.New("cannot add matrices due to incompatible dimensions")
+		return Matrix[T]{}
+	}
+
+	return elementwise(err, a, b, func(x, y T) (T, bool) {
+		s, overflow := addOverflows(x, y)
+		if !overflow {
+			return s, false
+		}
+		if y > 0 {
+			return maxValue[T](), false
+		}
+		return minValue[T](), false
+	})
+}
+
+// SaturatingSubtract subtracts a matrix from another one, clamping differences
+// that overflow T to the largest or smallest value of T.
+// Floating point matrices are subtracted as with Subtract.
+// The dimensions of the matrices must match.
+func (a Matrix[T]) SaturatingSubtract(err *error, b Matrix[T]) Matrix[T] {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return Matrix[T]{}
+	}
+
+	if !isInteger[T]() {
+		return a.Subtract(err, b)
+	}
+
+	// Check matrices can be subtracted.
+	if a.Dimensions != b.Dimensions {
+		*err = errors.New("cannot subtract matrices due to incompatible dimensions")
+		return Matrix[T]{}
+	}
+
+	return elementwise(err, a, b, func(x, y T) (T, bool) {
+		d, overflow := subtractOverflows(x, y)
+		if !overflow {
+			return d, false
+		}
+		if y < 0 {
+			return maxValue[T](), false
+		}
+		return minValue[T](), false
+	})
+}
+
+// SaturatingMultiply multiplies the matrix by another matrix, clamping values
+// that overflow T to the largest or smallest value of T.
+// Each cell is accumulated exactly before clamping.
+// Floating point matrices are multiplied as with Multiply.
+// The height of matix B must match the width of matrix A.
+func (a Matrix[T]) SaturatingMultiply(err *error, b Matrix[T]) Matrix[T] {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return Matrix[T]{}
+	}
+
+	if !isInteger[T]() {
+		return a.Multiply(err, b)
+	}
+
+	max, min := bigInt(maxValue[T]()), bigInt(minValue[T]())
+	return multiplyExact(err, a, b, func(v *big.Int) (T, bool) {
+		if v.Cmp(max) > 0 {
+			return maxValue[T](), false
+		}
+		if v.Cmp(min) < 0 {
+			return minValue[T](), false
+		}
+		x, _ := fromBigInt[T](v)
+		return x, false
+	})
+}
+
+// elementwise applies op to the corresponding values of two matrices of equal
+// dimensions, reporting an error if op reports an overflow.
+func elementwise[T constraints.Integer | constraints.Float](err *error, a, b Matrix[T], op func(x, y T) (T, bool)) Matrix[T] {
+	m := a.Clone()
+	for j := 0; j < a.Dimensions.Height; j++ {
+		for i := 0; i < a.Dimensions.Width; i++ {
+			v, overflow := op(a.Values[j][i], b.Values[j][i])
+			if overflow {
+				*err = overflowError(j, i)
+				return Matrix[T]{}
+			}
+			m.Values[j][i] = v
+		}
+	}
+	return m
+}
+
+// multiplyExact multiplies two integer matrices, accumulating each cell exactly
+// and converting it to T with convert, which reports whether it overflows.
+func multiplyExact[T constraints.Integer | constraints.Float](err *error, a, b Matrix[T], convert func(*big.Int) (T, bool)) Matrix[T] {
+	// Check matrices can be multiplied.
+	if a.Dimensions.Width != b.Dimensions.Height {
+		*err = errors.New("cannot multiply matrices due to incompatible dimensions")
+		return Matrix[T]{}
+	}
+
+	width := b.Dimensions.Width
+	height := a.Dimensions.Height
+	m := make([][]T, height)
+	sum := new(big.Int)
+	t := new(big.Int)
+	for j := 0; j < height; j++ {
+		m[j] = make([]T, width)
+		for x := 0; x < width; x++ {
+			sum.SetInt64(0)
+			for i := 0; i < a.Dimensions.Width; i++ {
+				sum.Add(sum, t.Mul(bigInt(a.Values[j][i]), bigInt(b.Values[i][x])))
+			}
+			v, overflow := convert(sum)
+			if overflow {
+				*err = overflowError(j, x)
+				return Matrix[T]{}
+			}
+			m[j][x] = v
+		}
+	}
+
+	return Matrix[T]{
+		Dimensions: Dimension{
+			Width:  width,
+			Height: height,
+		},
+		Values: m,
+	}
+}
+
+// addOverflows returns x + y, and whether the sum wrapped around.
+func addOverflows[T constraints.Integer | constraints.Float](x, y T) (T, bool) {
+	s := x + y
+	return s, (y > 0 && s < x) || (y < 0 && s > x)
+}
+
+// subtractOverflows returns x - y, and whether the difference wrapped around.
+func subtractOverflows[T constraints.Integer | constraints.Float](x, y T) (T, bool) {
+	d := x - y
+	return d, (y > 0 && d > x) || (y < 0 && d < x)
+}
+
+// overflowError reports an overflow in the cell at row j and column i, counting
+// from zero.
+func overflowError(j, i int) error {
+	return fmt.Errorf("result overflows the matrix element type at row %d, column %d", j, i)
+}
@@ -0,0 +1,147 @@
+package matrix
+
+import (
+	"math"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestCheckedAdd(t *testing.T) {
+	var err error
+
+	a := New(&err, []int8{100, -100}, []int8{1, 2})
+	b := New(&err, []int8{27, -28}, []int8{3, 4})
+	assert.NilError(t, err)
+
+	m := a.CheckedAdd(&err, b)
+	assert.NilError(t, err)
+	assert.Equal(t, m.String(), "[[127 -128] [4 6]]")
+
+	c := New(&err, []int8{0, 0}, []int8{0, 127})
+	assert.NilError(t, err)
+	_ = c.CheckedAdd(&err, b)
+	assert.ErrorContains(t, err, "result overflows the matrix element type at row 1, column 1")
+	err = nil
+
+	d := New(&err, []int8{0, -100}, []int8{0, 0})
+	assert.NilError(t, err)
+	_ = d.CheckedAdd(&err, a)
+	assert.ErrorContains(t, err, "result overflows the matrix element type at row 0, column 1")
+	err = nil
+
+	u := New(&err, []uint8{200})
+	assert.NilError(t, err)
+	_ = u.CheckedAdd(&err, u)
+	assert.ErrorContains(t, err, "result overflows the matrix element type at row 0, column 0")
+	err = nil
+
+	f := New(&err, []float64{math.MaxFloat64})
+	assert.NilError(t, err)
+	g := f.CheckedAdd(&err, f)
+	assert.NilError(t, err)
+	assert.Equal(t, g.Values[0][0], math.Inf(1))
+
+	_ = a.CheckedAdd(&err, New(&err, []int8{1}))
+	assert.ErrorContains(t, err, "cannot add matrices due to incompatible dimensions")
+}
+
+func TestCheckedSubtract(t *testing.T) {
+	var err error
+
+	a := New(&err, []int32{math.MinInt32 + 1, math.MaxInt32})
+	b := New(&err, []int32{1, 1})
+	assert.NilError(t, err)
+
+	_ = a.CheckedSubtract(&err, b)
+	assert.NilError(t, err)
+	_ = a.CheckedSubtract(&err, New(&err, []int32{2, 0}))
+	assert.ErrorContains(t, err, "result overflows the matrix element type at row 0, column 0")
+	err = nil
+
+	_ = a.CheckedSubtract(&err, New(&err, []int32{0, -1}))
+	assert.ErrorContains(t, err, "result overflows the matrix element type at row 0, column 1")
+	err = nil
+
+	u := New(&err, []uint{1, 5})
+	assert.NilError(t, err)
+	_ = u.CheckedSubtract(&err, New(&err, []uint{1, 6}))
+	assert.ErrorContains(t, err, "result overflows the matrix element type at row 0, column 1")
+}
+
+func TestCheckedMultiply(t *testing.T) {
+	var err error
+
+	a := New(&err, []int8{2, 2}, []int8{1, 2})
+	b := New(&err, []int8{100, -1}, []int8{-100, 1})
+	assert.NilError(t, err)
+
+	// The products 2*100 and 2*-100 overflow int8, but their sum does not.
+	m := a.CheckedMultiply(&err, b)
+	assert.NilError(t, err)
+	assert.Equal(t, m.String(), "[[0 0] [-100 1]]")
+
+	c := New(&err, []int8{10, 10}, []int8{1, 2})
+	d := New(&err, []int8{10, 0}, []int8{10, 1})
+	assert.NilError(t, err)
+	_ = c.CheckedMultiply(&err, d)
+	assert.ErrorContains(t, err, "result overflows the matrix element type at row 0, column 0")
+	err = nil
+
+	e := New(&err, []int64{-1})
+	g := New(&err, []int64{math.MinInt64})
+	assert.NilError(t, err)
+	_ = e.CheckedMultiply(&err, g)
+	assert.ErrorContains(t, err, "result overflows the matrix element type at row 0, column 0")
+	err = nil
+
+	h := New(&err, []float32{2}).CheckedMultiply(&err, New(&err, []float32{3}))
+	assert.NilError(t, err)
+	assert.Equal(t, h.Values[0][0], float32(6))
+
+	_ = a.CheckedMultiply(&err, New(&err, []int8{1, 2}))
+	assert.ErrorContains(t, err, "cannot multiply matrices due to incompatible dimensions")
+}
+
+func TestSaturating(t *testing.T) {
+	var err error
+
+	a := New(&err, []int8{100, -100, 5})
+	b := New(&err, []int8{100, -100, -5})
+	assert.NilError(t, err)
+
+	m := a.SaturatingAdd(&err, b)
+	assert.NilError(t, err)
+	assert.Equal(t, m.String(), "[[127 -128 0]]")
+
+	m = a.SaturatingSubtract(&err, b.MultiplyScalar(&err, -1))
+	assert.NilError(t, err)
+	assert.Equal(t, m.String(), "[[127 -128 0]]")
+
+	u := New(&err, []uint16{1, 65000})
+	v := New(&err, []uint16{2, 1000})
+	assert.NilError(t, err)
+	n := u.SaturatingSubtract(&err, v)
+	assert.NilError(t, err)
+	assert.Equal(t, n.String(), "[[0 64000]]")
+	n = u.SaturatingAdd(&err, v)
+	assert.NilError(t, err)
+	assert.Equal(t, n.String(), "[[3 65535]]")
+
+	c := New(&err, []int8{10, 10}, []int8{-10, 1})
+	d := New(&err, []int8{10, 0}, []int8{10, 1})
+	assert.NilError(t, err)
+	m = c.SaturatingMultiply(&err, d)
+	assert.NilError(t, err)
+	assert.Equal(t, m.String(), "[[127 10] [-90 1]]")
+
+	e := New(&err, []int8{-100, 2})
+	f := New(&err, []int8{2}, []int8{-100})
+	assert.NilError(t, err)
+	m = e.SaturatingMultiply(&err, f)
+	assert.NilError(t, err)
+	assert.Equal(t, m.String(), "[[-128]]")
+
+	_ = a.SaturatingAdd(&err, New(&err, []int8{1}))
+	assert.ErrorContains(t, err, "cannot add matrices due to incompatible dimensions")
+}
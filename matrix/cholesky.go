@@ -0,0 +1,166 @@
+package matrix
+
+import (
+	"errors"
+	"math"
+
+	"golang.org/x/exp/constraints"
+)
+
+// LDL is the LDL' factorization of a symmetric matrix, such that A = L * D * L'.
+// The factors are floating point for every element type.
+type LDL[T constraints.Integer | constraints.Float] struct {
+	// L is unit lower triangular.
+	L Matrix[float64]
+	// D is diagonal.
+	D Matrix[float64]
+}
+
+// Cholesky factorizes a symmetric positive-definite matrix into L * L', where
+// L is lower triangular with a positive diagonal, and returns L. The factor is
+// floating point for every element type, since it involves square roots.
+// https://en.wikipedia.org/wiki/Cholesky_decomposition
+func (a Matrix[T]) Cholesky(err *error) Matrix[float64] {
+	// Avoid hiding previous errors.
+	if *err != nil {
+		return Matrix[float64]{}
+	}
+
+	l := a.cholesky(err)
+	if *err != nil {
+		return Matrix[float64]{}
+	}
+	return fromFloat64[float64](l)
+}
+
+// IsPositiveDefinite reports whether the matrix is symmetric positive-definite.
+func (a Matrix[T]) IsPositiveDefinite() bool {
+	var err error
+	_ = a.cholesky(&err)
+	return err == nil
+}
+
+// IsSymmetric reports whether the matrix is square and equal to its transpose,
+// to within rounding error for floating point matrices.
+func (a Matrix[T]) IsSymmetric() bool {
+	return isSymmetric(a.float64Values())
+}
+
+// LDL factorizes a symmetric matrix into L * D * L', where L is unit lower
+// triangular and D is diagonal. Unlike Cholesky, it does not take square roots,
+// so it also handles positive semi-definite and indefinite matrices.
+// https://en.wikipedia.org/wiki/Cholesky_decomposition#LDL_decomposition_2
+func (a Matrix[T]) LDL(err *error) LDL[T] {
+	// Avoid hiding previous errors.
+	if *err != nil {
+		return LDL[T]{}
+	}
+
+	values := a.float64Values()
+	if a.Dimensions.Height != a.Dimensions.Width {
+		*err = errors.New("cannot calculate the LDL factorization of a non-square matrix")
+		return LDL[T]{}
+	}
+	if !isSymmetric(values) {
+		*err = errors.New("cannot calculate the LDL factorization, matrix is not symmetric")
+		return LDL[T]{}
+	}
+
+	n := a.Dimensions.Width
+	limit := DefaultPivotTolerance * maxAbs(values)
+	l := identity(n)
+	d := make([][]float64, n)
+	for j := 0; j < n; j++ {
+		d[j] = make([]float64, n)
+
+		sum := values[j][j]
+		for k := 0; k < j; k++ {
+			sum -= l[j][k] * l[j][k] * d[k][k]
+		}
+		d[j][j] = sum
+
+		for i := j + 1; i < n; i++ {
+			sum := values[i][j]
+			for k := 0; k < j; k++ {
+				sum -= l[i][k] * l[j][k] * d[k][k]
+			}
+
+			// A zero pivot is only allowed when the rest of its column is also zero.
+			if math.Abs(d[j][j]) <= limit {
+				if math.Abs(sum) > limit {
+					*err = errors.New("cannot calculate the LDL factorization without pivoting")
+					return LDL[T]{}
+				}
+				continue
+			}
+			l[i][j] = sum / d[j][j]
+		}
+		if math.Abs(d[j][j]) <= limit {
+			d[j][j] = 0
+		}
+	}
+
+	return LDL[T]{
+		L: fromFloat64[float64](l),
+		D: fromFloat64[float64](d),
+	}
+}
+
+// cholesky calculates the Cholesky factor of the matrix in float64.
+func (a Matrix[T]) cholesky(err *error) [][]float64 {
+	values := a.float64Values()
+	if a.Dimensions.Height != a.Dimensions.Width {
+		*err = errors.New("cannot calculate the Cholesky factorization of a non-square matrix")
+		return nil
+	}
+	if !isSymmetric(values) {
+		*err = errors.New("cannot calculate the Cholesky factorization, matrix is not symmetric")
+		return nil
+	}
+
+	n := a.Dimensions.Width
+	limit := DefaultPivotTolerance * maxAbs(values)
+	l := make([][]float64, n)
+	for j := 0; j < n; j++ {
+		l[j] = make([]float64, n)
+	}
+	for j := 0; j < n; j++ {
+		sum := values[j][j]
+		for k := 0; k < j; k++ {
+			sum -= l[j][k] * l[j][k]
+		}
+		if sum <= limit {
+			*err = errors.New("cannot calculate the Cholesky factorization, matrix is not positive definite")
+			return nil
+		}
+		l[j][j] = math.Sqrt(sum)
+
+		for i := j + 1; i < n; i++ {
+			sum := values[i][j]
+			for k := 0; k < j; k++ {
+				sum -= l[i][k] * l[j][k]
+			}
+			l[i][j] = sum / l[j][j]
+		}
+	}
+	return l
+}
+
+// isSymmetric reports whether values form a symmetric matrix, allowing for
+// rounding error relative to the largest magnitude.
+func isSymmetric(values [][]float64) bool {
+	n := len(values)
+	if n == 0 || len(values[0]) != n {
+		return false
+	}
+
+	limit := DefaultPivotTolerance * maxAbs(values)
+	for j := 0; j < n; j++ {
+		for i := j + 1; i < n; i++ {
+			if math.Abs(values[j][i]-values[i][j]) > limit {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,155 @@
+package matrix
+
+import (
+	"math"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestCholesky(t *testing.T) {
+	var err error
+
+	a := New(&err, []float64{4, 12, -16}, []float64{12, 37, -43}, []float64{-16, -43, 98})
+	assert.NilError(t, err)
+	l := a.Cholesky(&err)
+	assert.NilError(t, err)
+	r := New(&err, []float64{2, 0, 0}, []float64{6, 1, 0}, []float64{-8, 5, 3})
+	assert.NilError(t, err)
+	assert.Check(t, l.ApproxEqual(r, 0.0001))
+
+	// Validate L * L' = A
+	m := l.Multiply(&err, l.Transpose(&err))
+	assert.NilError(t, err)
+	assert.Check(t, m.ApproxEqual(a, 0.0001))
+
+	// Integer matrix with an integer factor
+	b := New(&err, []int{4, 2}, []int{2, 10})
+	assert.NilError(t, err)
+	k := b.Cholesky(&err)
+	assert.NilError(t, err)
+	s := New(&err, []float64{2, 0}, []float64{1, 3})
+	assert.NilError(t, err)
+	assert.Check(t, k.Equal(s))
+
+	// Integer matrix with an irrational factor
+	b = New(&err, []int{2, 1}, []int{1, 2})
+	assert.NilError(t, err)
+	k = b.Cholesky(&err)
+	assert.NilError(t, err)
+	assert.Check(t, math.Abs(k.Values[0][0]-math.Sqrt2) < 1e-15)
+	m = k.Multiply(&err, k.Transpose(&err))
+	assert.NilError(t, err)
+	assert.Check(t, m.ApproxEqual(New(&err, []float64{2, 1}, []float64{1, 2}), 1e-12))
+}
+
+func TestCholeskyErrors(t *testing.T) {
+	var err error
+
+	a := New(&err, []float64{1, 2, 3}, []float64{4, 5, 6})
+	assert.NilError(t, err)
+	_ = a.Cholesky(&err)
+	assert.ErrorContains(t, err, "cannot calculate the Cholesky factorization of a non-square matrix")
+	err = nil
+
+	a = New(&err, []float64{2, 1}, []float64{0, 2})
+	assert.NilError(t, err)
+	_ = a.Cholesky(&err)
+	assert.ErrorContains(t, err, "cannot calculate the Cholesky factorization, matrix is not symmetric")
+	err = nil
+
+	a = New(&err, []float64{1, 2}, []float64{2, 1})
+	assert.NilError(t, err)
+	m := a.Cholesky(&err)
+	assert.ErrorContains(t, err, "cannot calculate the Cholesky factorization, matrix is not positive definite")
+	assert.Check(t, m.Equal(Matrix[float64]{}))
+}
+
+func TestIsPositiveDefinite(t *testing.T) {
+	var err error
+
+	a := New(&err, []float64{2, -1, 0}, []float64{-1, 2, -1}, []float64{0, -1, 2})
+	assert.NilError(t, err)
+	assert.Check(t, a.IsPositiveDefinite())
+
+	// Semi-definite
+	a = New(&err, []float64{1, 1}, []float64{1, 1})
+	assert.NilError(t, err)
+	assert.Check(t, !a.IsPositiveDefinite())
+
+	// Not symmetric
+	a = New(&err, []float64{2, 1}, []float64{0, 2})
+	assert.NilError(t, err)
+	assert.Check(t, !a.IsPositiveDefinite())
+}
+
+func TestIsSymmetric(t *testing.T) {
+	var err error
+
+	a := New(&err, []int{1, 2}, []int{2, 1})
+	assert.NilError(t, err)
+	assert.Check(t, a.IsSymmetric())
+
+	a = New(&err, []int{1, 2}, []int{3, 1})
+	assert.NilError(t, err)
+	assert.Check(t, !a.IsSymmetric())
+
+	a = New(&err, []int{1, 2})
+	assert.NilError(t, err)
+	assert.Check(t, !a.IsSymmetric())
+}
+
+func TestLDL(t *testing.T) {
+	var err error
+
+	// Positive definite - validate L * D * L' = A
+	a := New(&err, []float64{4, 12, -16}, []float64{12, 37, -43}, []float64{-16, -43, 98})
+	assert.NilError(t, err)
+	f := a.LDL(&err)
+	assert.NilError(t, err)
+	m := f.L.Multiply(&err, f.D).Multiply(&err, f.L.Transpose(&err))
+	assert.NilError(t, err)
+	assert.Check(t, m.ApproxEqual(a, 0.0001))
+	d := New(&err, []float64{4, 0, 0}, []float64{0, 1, 0}, []float64{0, 0, 9})
+	assert.NilError(t, err)
+	assert.Check(t, f.D.ApproxEqual(d, 0.0001))
+
+	// Positive semi-definite
+	a = New(&err, []float64{1, 1, 0}, []float64{1, 1, 0}, []float64{0, 0, 2})
+	assert.NilError(t, err)
+	f = a.LDL(&err)
+	assert.NilError(t, err)
+	m = f.L.Multiply(&err, f.D).Multiply(&err, f.L.Transpose(&err))
+	assert.NilError(t, err)
+	assert.Check(t, m.ApproxEqual(a, 0.0001))
+	assert.Equal(t, f.D.Values[1][1], 0.0)
+
+	// Integer matrices have fractional multipliers.
+	b := New(&err, []int{2, 1}, []int{1, 2})
+	assert.NilError(t, err)
+	g := b.LDL(&err)
+	assert.NilError(t, err)
+	assert.Equal(t, g.L.Values[1][0], 0.5)
+	assert.Equal(t, g.D.Values[1][1], 1.5)
+}
+
+func TestLDLErrors(t *testing.T) {
+	var err error
+
+	a := New(&err, []float64{1, 2, 3}, []float64{4, 5, 6})
+	assert.NilError(t, err)
+	_ = a.LDL(&err)
+	assert.ErrorContains(t, err, "cannot calculate the LDL factorization of a non-square matrix")
+	err = nil
+
+	a = New(&err, []float64{2, 1}, []float64{0, 2})
+	assert.NilError(t, err)
+	_ = a.LDL(&err)
+	assert.ErrorContains(t, err, "cannot calculate the LDL factorization, matrix is not symmetric")
+	err = nil
+
+	a = New(&err, []float64{0, 1}, []float64{1, 0})
+	assert.NilError(t, err)
+	_ = a.LDL(&err)
+	assert.ErrorContains(t, err, "cannot calculate the LDL factorization without pivoting")
+}
@@ -0,0 +1,401 @@
+package matrix
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/cmplx"
+
+	"golang.org/x/exp/constraints"
+)
+
+// ComplexMatrix is a matrix with complex elements.
+type ComplexMatrix[T constraints.Complex] struct {
+	Dimensions Dimension
+	Values     [][]T
+}
+
+// NewComplex instantiates a ComplexMatrix with the passed values.
+func NewComplex[T constraints.Complex](err *error, values ...[]T) ComplexMatrix[T] {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return ComplexMatrix[T]{}
+	}
+
+	height := len(values)
+	if height < 1 {
+		*err = errors.New("cannot create a Matrix with a dimension that is less than 1")
+		return ComplexMatrix[T]{}
+	}
+
+	width := len(values[0])
+	if width < 1 {
+		*err = errors.New("cannot create a Matrix with a dimension that is less than 1")
+		return ComplexMatrix[T]{}
+	}
+
+	for _, row := range values {
+		if len(row) != width {
+			*err = errors.New("cannot create a Matrix with different row lengths")
+			return ComplexMatrix[T]{}
+		}
+	}
+
+	return ComplexMatrix[T]{
+		Dimensions: Dimension{
+			Width:  width,
+			Height: height,
+		},
+		Values: values,
+	}
+}
+
+// NewComplexIdentity instantiates a complex identity matrix of the specified dimensions.
+func NewComplexIdentity[T constraints.Complex](err *error, dim Dimension) ComplexMatrix[T] {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return ComplexMatrix[T]{}
+	}
+
+	if dim.Width < 1 || dim.Height < 1 {
+		*err = errors.New("cannot create a Matrix with a dimension that is less than 1")
+		return ComplexMatrix[T]{}
+	}
+
+	// Check the matrix is square.
+	if dim.Height != dim.Width {
+		*err = errors.New("an identity matrix must be square")
+		return ComplexMatrix[T]{}
+	}
+
+	values := make([][]T, dim.Height)
+	for y := 0; y < dim.Height; y++ {
+		values[y] = make([]T, dim.Width)
+		values[y][y] = 1
+	}
+
+	return ComplexMatrix[T]{
+		Dimensions: dim,
+		Values:     values,
+	}
+}
+
+// Complex converts a Matrix to a ComplexMatrix with zero imaginary parts.
+func (a Matrix[T]) Complex() ComplexMatrix[complex128] {
+	values := make([][]complex128, a.Dimensions.Height)
+	for j := 0; j < a.Dimensions.Height; j++ {
+		values[j] = make([]complex128, a.Dimensions.Width)
+		for i := 0; i < a.Dimensions.Width; i++ {
+			values[j][i] = complex(float64(a.Values[j][i]), 0)
+		}
+	}
+	return fromComplex128[complex128](values)
+}
+
+// MultiplyScalar multiplies the Matrix by a scalar.
+func (a ComplexMatrix[T]) MultiplyScalar(err *error, x T) ComplexMatrix[T] {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return ComplexMatrix[T]{}
+	}
+
+	m := a.Clone()
+	for j := 0; j < a.Dimensions.Height; j++ {
+		for i := 0; i < a.Dimensions.Width; i++ {
+			m.Values[j][i] *= x
+		}
+	}
+	return m
+}
+
+// Multiply multiplies the matrix by another matrix.
+// The height of matix B must match the width of matrix A.
+func (a ComplexMatrix[T]) Multiply(err *error, b ComplexMatrix[T]) ComplexMatrix[T] {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return ComplexMatrix[T]{}
+	}
+
+	// Check matrices can be multiplied.
+	if a.Dimensions.Width != b.Dimensions.Height {
+		*err = errors.New("cannot multiply matrices due to incompatible dimensions")
+		return ComplexMatrix[T]{}
+	}
+
+	width := b.Dimensions.Width
+	height := a.Dimensions.Height
+	m := make([][]T, height)
+	for j := 0; j < height; j++ {
+		m[j] = make([]T, width)
+		for x := 0; x < width; x++ {
+			sum := T(0)
+			for i := 0; i < a.Dimensions.Width; i++ {
+				sum += a.Values[j][i] * b.Values[i][x]
+			}
+			m[j][x] = sum
+		}
+	}
+
+	return ComplexMatrix[T]{
+		Dimensions: Dimension{
+			Width:  width,
+			Height: height,
+		},
+		Values: m,
+	}
+}
+
+// Add a matrix to another one.
+// The dimensions of the matrices must match.
+func (a ComplexMatrix[T]) Add(err *error, b ComplexMatrix[T]) ComplexMatrix[T] {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return ComplexMatrix[T]{}
+	}
+
+	// Check matrices can be added.
+	if a.Dimensions != b.Dimensions {
+		*err = errors.New("cannot add matrices due to incompatible dimensions")
+		return ComplexMatrix[T]{}
+	}
+
+	m := a.Clone()
+	for j := 0; j < a.Dimensions.Height; j++ {
+		for i := 0; i < a.Dimensions.Width; i++ {
+			m.Values[j][i] += b.Values[j][i]
+		}
+	}
+	return m
+}
+
+// Subtract a matrix from another one.
+// The dimensions of the matrices must match.
+func (a ComplexMatrix[T]) Subtract(err *error, b ComplexMatrix[T]) ComplexMatrix[T] {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return ComplexMatrix[T]{}
+	}
+
+	// Check matrices can be subtracted.
+	if a.Dimensions != b.Dimensions {
+		*err = errors.New("cannot subtract matrices due to incompatible dimensions")
+		return ComplexMatrix[T]{}
+	}
+
+	m := a.Clone()
+	for j := 0; j < a.Dimensions.Height; j++ {
+		for i := 0; i < a.Dimensions.Width; i++ {
+			m.Values[j][i] -= b.Values[j][i]
+		}
+	}
+	return m
+}
+
+// Inverse a matrix using Gauss-Jordan elimination with partial pivoting.
+func (a ComplexMatrix[T]) Inverse(err *error) ComplexMatrix[T] {
+	// Avoid hiding previous errors.
+	if *err != nil {
+		return ComplexMatrix[T]{}
+	}
+
+	// Check the matrix is square.
+	if a.Dimensions.Height != a.Dimensions.Width {
+		*err = errors.New("cannot calculate the inverse of a non-square matrix")
+		return ComplexMatrix[T]{}
+	}
+
+	n := a.Dimensions.Width
+	values := a.complex128Values()
+	inv := make([][]complex128, n)
+	scale := 0.0
+	for j := 0; j < n; j++ {
+		inv[j] = make([]complex128, n)
+		inv[j][j] = 1
+		for i := 0; i < n; i++ {
+			scale = math.Max(scale, cmplx.Abs(values[j][i]))
+		}
+	}
+
+	limit := DefaultPivotTolerance * scale
+	for k := 0; k < n; k++ {
+		// Select the row with the largest pivot candidate.
+		p := k
+		for j := k + 1; j < n; j++ {
+			if cmplx.Abs(values[j][k]) > cmplx.Abs(values[p][k]) {
+				p = j
+			}
+		}
+		if values[p][k] == 0 {
+			*err = errors.New("cannot invert, matrix is singular")
+			return ComplexMatrix[T]{}
+		}
+		if cmplx.Abs(values[p][k]) <= limit {
+			*err = errors.New("cannot invert, matrix is nearly singular")
+			return ComplexMatrix[T]{}
+		}
+		values[k], values[p] = values[p], values[k]
+		inv[k], inv[p] = inv[p], inv[k]
+
+		// Scale the pivot row so the pivot is 1.
+		pivot := values[k][k]
+		for i := 0; i < n; i++ {
+			values[k][i] /= pivot
+			inv[k][i] /= pivot
+		}
+
+		// Eliminate the pivot column from every other row.
+		for j := 0; j < n; j++ {
+			f := values[j][k]
+			if j == k || f == 0 {
+				continue
+			}
+			for i := 0; i < n; i++ {
+				values[j][i] -= f * values[k][i]
+				inv[j][i] -= f * inv[k][i]
+			}
+		}
+	}
+
+	return fromComplex128[T](inv)
+}
+
+// Transpose calculates the transpose of a Matrix.
+func (a ComplexMatrix[T]) Transpose(err *error) ComplexMatrix[T] {
+	values := make([][]T, a.Dimensions.Width)
+	for j := 0; j < a.Dimensions.Width; j++ {
+		values[j] = make([]T, a.Dimensions.Height)
+		for i := 0; i < a.Dimensions.Height; i++ {
+			values[j][i] = a.Values[i][j]
+		}
+	}
+
+	return ComplexMatrix[T]{
+		Dimensions: Dimension{
+			Width:  a.Dimensions.Height,
+			Height: a.Dimensions.Width,
+		},
+		Values: values,
+	}
+}
+
+// ConjugateTranspose calculates the conjugate transpose of a Matrix.
+// https://en.wikipedia.org/wiki/Conjugate_transpose
+func (a ComplexMatrix[T]) ConjugateTranspose(err *error) ComplexMatrix[T] {
+	m := a.Transpose(err)
+	for j := 0; j < m.Dimensions.Height; j++ {
+		for i := 0; i < m.Dimensions.Width; i++ {
+			m.Values[j][i] = T(cmplx.Conj(complex128(m.Values[j][i])))
+		}
+	}
+	return m
+}
+
+// IsHermitian reports whether the matrix is equal to its conjugate transpose,
+// to within rounding error relative to the largest magnitude.
+func (a ComplexMatrix[T]) IsHermitian() bool {
+	if a.Dimensions.Height != a.Dimensions.Width || a.Dimensions.Height == 0 {
+		return false
+	}
+
+	values := a.complex128Values()
+	scale := 0.0
+	for _, row := range values {
+		for _, v := range row {
+			scale = math.Max(scale, cmplx.Abs(v))
+		}
+	}
+
+	limit := DefaultPivotTolerance * scale
+	for j := range values {
+		for i := j; i < len(values); i++ {
+			if cmplx.Abs(values[j][i]-cmplx.Conj(values[i][j])) > limit {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (a ComplexMatrix[T]) Clone() ComplexMatrix[T] {
+	values := make([][]T, a.Dimensions.Height)
+	for j := 0; j < a.Dimensions.Height; j++ {
+		values[j] = make([]T, a.Dimensions.Width)
+		copy(values[j], a.Values[j])
+	}
+
+	return ComplexMatrix[T]{
+		Dimensions: a.Dimensions,
+		Values:     values,
+	}
+}
+
+func (a ComplexMatrix[T]) Equal(b ComplexMatrix[T]) bool {
+	if a.Dimensions != b.Dimensions {
+		return false
+	}
+	for j := 0; j < a.Dimensions.Height; j++ {
+		for i := 0; i < a.Dimensions.Width; i++ {
+			if a.Values[j][i] != b.Values[j][i] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// ApproxEqual reports whether every element of the matrices is within
+// errorMargin of each other, measured by the modulus of their difference.
+func (a ComplexMatrix[T]) ApproxEqual(b ComplexMatrix[T], errorMargin float64) bool {
+	if a.Dimensions != b.Dimensions {
+		return false
+	}
+	for j := 0; j < a.Dimensions.Height; j++ {
+		for i := 0; i < a.Dimensions.Width; i++ {
+			if cmplx.Abs(complex128(a.Values[j][i])-complex128(b.Values[j][i])) > errorMargin {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (a ComplexMatrix[T]) String() string {
+	return fmt.Sprint(a.Values)
+}
+
+// complex128Values returns a copy of the matrix values converted to complex128.
+func (a ComplexMatrix[T]) complex128Values() [][]complex128 {
+	values := make([][]complex128, a.Dimensions.Height)
+	for j := 0; j < a.Dimensions.Height; j++ {
+		values[j] = make([]complex128, a.Dimensions.Width)
+		for i := 0; i < a.Dimensions.Width; i++ {
+			values[j][i] = complex128(a.Values[j][i])
+		}
+	}
+	return values
+}
+
+// fromComplex128 creates a ComplexMatrix from complex128 values.
+func fromComplex128[T constraints.Complex](values [][]complex128) ComplexMatrix[T] {
+	height := len(values)
+	width := 0
+	if height > 0 {
+		width = len(values[0])
+	}
+
+	m := make([][]T, height)
+	for j := 0; j < height; j++ {
+		m[j] = make([]T, width)
+		for i := 0; i < width; i++ {
+			m[j][i] = T(values[j][i])
+		}
+	}
+
+	return ComplexMatrix[T]{
+		Dimensions: Dimension{
+			Width:  width,
+			Height: height,
+		},
+		Values: m,
+	}
+}
@@ -0,0 +1,168 @@
+package matrix
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestNewComplex(t *testing.T) {
+	var err error
+
+	a := NewComplex(&err, []complex128{1 + 1i, 2}, []complex128{3, 4 - 2i})
+	assert.NilError(t, err)
+	assert.Equal(t, a.Dimensions, Dimension{Width: 2, Height: 2})
+	assert.Equal(t, a.String(), "[[(1+1i) (2+0i)] [(3+0i) (4-2i)]]")
+
+	_ = NewComplex[complex64](&err)
+	assert.ErrorContains(t, err, "cannot create a Matrix with a dimension that is less than 1")
+	err = nil
+
+	_ = NewComplex(&err, []complex64{1}, []complex64{2, 3})
+	assert.ErrorContains(t, err, "cannot create a Matrix with different row lengths")
+	err = nil
+
+	i := NewComplexIdentity[complex64](&err, Dimension{Width: 2, Height: 2})
+	assert.NilError(t, err)
+	r := NewComplex(&err, []complex64{1, 0}, []complex64{0, 1})
+	assert.NilError(t, err)
+	assert.Check(t, i.Equal(r))
+
+	_ = NewComplexIdentity[complex64](&err, Dimension{Width: 2, Height: 3})
+	assert.ErrorContains(t, err, "must be square")
+}
+
+func TestComplexConversion(t *testing.T) {
+	var err error
+
+	a := New(&err, []int{1, 2}, []int{3, 4})
+	assert.NilError(t, err)
+	c := a.Complex()
+	r := NewComplex(&err, []complex128{1, 2}, []complex128{3, 4})
+	assert.NilError(t, err)
+	assert.Check(t, c.Equal(r))
+}
+
+func TestComplexArithmetic(t *testing.T) {
+	var err error
+
+	a := NewComplex(&err, []complex128{1 + 1i, 2}, []complex128{0, 1i})
+	assert.NilError(t, err)
+	b := NewComplex(&err, []complex128{1, 1i}, []complex128{1i, 1})
+	assert.NilError(t, err)
+
+	m := a.Multiply(&err, b)
+	assert.NilError(t, err)
+	r := NewComplex(&err, []complex128{1 + 3i, 1 + 1i}, []complex128{-1, 1i})
+	assert.NilError(t, err)
+	assert.Check(t, m.Equal(r))
+
+	m = a.Add(&err, b)
+	assert.NilError(t, err)
+	r = NewComplex(&err, []complex128{2 + 1i, 2 + 1i}, []complex128{1i, 1 + 1i})
+	assert.NilError(t, err)
+	assert.Check(t, m.Equal(r))
+
+	m = a.Subtract(&err, b)
+	assert.NilError(t, err)
+	r = NewComplex(&err, []complex128{1i, 2 - 1i}, []complex128{-1i, -1 + 1i})
+	assert.NilError(t, err)
+	assert.Check(t, m.Equal(r))
+
+	m = a.MultiplyScalar(&err, 1i)
+	assert.NilError(t, err)
+	r = NewComplex(&err, []complex128{-1 + 1i, 2i}, []complex128{0, -1})
+	assert.NilError(t, err)
+	assert.Check(t, m.Equal(r))
+
+	c := NewComplex(&err, []complex128{1, 2, 3})
+	assert.NilError(t, err)
+	_ = c.Multiply(&err, a)
+	assert.ErrorContains(t, err, "cannot multiply matrices due to incompatible dimensions")
+	err = nil
+	_ = c.Add(&err, a)
+	assert.ErrorContains(t, err, "cannot add matrices due to incompatible dimensions")
+	err = nil
+	_ = c.Subtract(&err, a)
+	assert.ErrorContains(t, err, "cannot subtract matrices due to incompatible dimensions")
+}
+
+func TestComplexInverse(t *testing.T) {
+	var err error
+
+	a := NewComplex(&err,
+		[]complex128{1 + 1i, 2, 0},
+		[]complex128{0, 1i, 3},
+		[]complex128{1, 0, 2 - 1i},
+	)
+	assert.NilError(t, err)
+	inv := a.Inverse(&err)
+	assert.NilError(t, err)
+	m := a.Multiply(&err, inv)
+	assert.NilError(t, err)
+	i := NewComplexIdentity[complex128](&err, a.Dimensions)
+	assert.NilError(t, err)
+	assert.Check(t, m.ApproxEqual(i, 0.0001))
+
+	a = NewComplex(&err, []complex128{1i, 2i}, []complex128{1, 2})
+	assert.NilError(t, err)
+	_ = a.Inverse(&err)
+	assert.ErrorContains(t, err, "cannot invert, matrix is")
+}
+
+func TestConjugateTranspose(t *testing.T) {
+	var err error
+
+	a := NewComplex(&err, []complex64{1 + 1i, 2 - 3i, 4i})
+	assert.NilError(t, err)
+	m := a.ConjugateTranspose(&err)
+	r := NewComplex(&err, []complex64{1 - 1i}, []complex64{2 + 3i}, []complex64{-4i})
+	assert.NilError(t, err)
+	assert.Check(t, m.Equal(r))
+
+	m = a.Transpose(&err)
+	r = NewComplex(&err, []complex64{1 + 1i}, []complex64{2 - 3i}, []complex64{4i})
+	assert.NilError(t, err)
+	assert.Check(t, m.Equal(r))
+}
+
+func TestIsHermitian(t *testing.T) {
+	var err error
+
+	a := NewComplex(&err, []complex128{2, 1 - 1i}, []complex128{1 + 1i, 3})
+	assert.NilError(t, err)
+	assert.Check(t, a.IsHermitian())
+
+	// Symmetric but not Hermitian
+	a = NewComplex(&err, []complex128{2, 1 + 1i}, []complex128{1 + 1i, 3})
+	assert.NilError(t, err)
+	assert.Check(t, !a.IsHermitian())
+
+	// Imaginary diagonal
+	a = NewComplex(&err, []complex128{1i})
+	assert.NilError(t, err)
+	assert.Check(t, !a.IsHermitian())
+
+	a = NewComplex(&err, []complex128{1, 2})
+	assert.NilError(t, err)
+	assert.Check(t, !a.IsHermitian())
+}
+
+func TestComplexApproxEqual(t *testing.T) {
+	var err error
+
+	a := NewComplex(&err, []complex128{1 + 1i, 2})
+	assert.NilError(t, err)
+	b := NewComplex(&err, []complex128{1 + 1.00001i, 2})
+	assert.NilError(t, err)
+	assert.Check(t, a.ApproxEqual(b, 0.0001))
+	assert.Check(t, !a.Equal(b))
+
+	b = NewComplex(&err, []complex128{1 + 1.1i, 2})
+	assert.NilError(t, err)
+	assert.Check(t, !a.ApproxEqual(b, 0.0001))
+
+	c := NewComplex(&err, []complex128{1 + 1i}, []complex128{2})
+	assert.NilError(t, err)
+	assert.Check(t, !a.ApproxEqual(c, 0.0001))
+}
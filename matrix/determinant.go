@@ -0,0 +1,109 @@
+package matrix
+
+import (
+	"errors"
+	"math/big"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Determinant calculates the determinant of a square matrix.
+// Floating point matrices use an LU factorization. Integer matrices use the
+// fraction-free Bareiss algorithm so the result is exact.
+func (a Matrix[T]) Determinant(err *error) T {
+	// Avoid hiding previous errors.
+	if *err != nil {
+		return 0
+	}
+
+	// Check the matrix is square.
+	if a.Dimensions.Height != a.Dimensions.Width {
+		*err = errors.New("cannot calculate the determinant of a non-square matrix")
+		return 0
+	}
+
+	if isInteger[T]() {
+		return a.bareiss(err)
+	}
+	return a.LU(err).Determinant(err)
+}
+
+// bareiss calculates the determinant of an integer matrix exactly.
+// Intermediate values use arbitrary precision, so an error is only reported if
+// the determinant itself does not fit in T.
+// https://en.wikipedia.org/wiki/Bareiss_algorithm
+func (a Matrix[T]) bareiss(err *error) T {
+	n := a.Dimensions.Width
+	m := make([][]*big.Int, n)
+	for j := 0; j < n; j++ {
+		m[j] = make([]*big.Int, n)
+		for i := 0; i < n; i++ {
+			m[j][i] = bigInt(a.Values[j][i])
+		}
+	}
+
+	negative := false
+	prev := big.NewInt(1)
+	t := new(big.Int)
+	for k := 0; k < n-1; k++ {
+		// Swap in a row with a non-zero pivot.
+		if m[k][k].Sign() == 0 {
+			p := -1
+			for j := k + 1; j < n; j++ {
+				if m[j][k].Sign() != 0 {
+					p = j
+					break
+				}
+			}
+			if p < 0 {
+				return 0
+			}
+			m[k], m[p] = m[p], m[k]
+			negative = !negative
+		}
+
+		for j := k + 1; j < n; j++ {
+			for i := k + 1; i < n; i++ {
+				// m[j][i] = (m[j][i]*m[k][k] - m[j][k]*m[k][i]) / prev, which divides exactly.
+				m[j][i].Mul(m[j][i], m[k][k])
+				m[j][i].Sub(m[j][i], t.Mul(m[j][k], m[k][i]))
+				m[j][i].Quo(m[j][i], prev)
+			}
+		}
+		prev = m[k][k]
+	}
+
+	det := m[n-1][n-1]
+	if negative {
+		det.Neg(det)
+	}
+
+	v, ok := fromBigInt[T](det)
+	if !ok {
+		*err = errors.New("determinant overflows the matrix element type")
+		return 0
+	}
+	return v
+}
+
+// bigInt converts an integer value to a big.Int.
+func bigInt[T constraints.Integer | constraints.Float](v T) *big.Int {
+	if v < 0 {
+		return big.NewInt(int64(v))
+	}
+	return new(big.Int).SetUint64(uint64(v))
+}
+
+// fromBigInt converts a big.Int to the integer type T, reporting whether the
+// value fits.
+func fromBigInt[T constraints.Integer | constraints.Float](v *big.Int) (T, bool) {
+	if v.IsInt64() {
+		x := T(v.Int64())
+		return x, bigInt(x).Cmp(v) == 0
+	}
+	if v.IsUint64() {
+		x := T(v.Uint64())
+		return x, bigInt(x).Cmp(v) == 0
+	}
+	return 0, false
+}
@@ -0,0 +1,86 @@
+package matrix
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestDeterminant(t *testing.T) {
+	var err error
+
+	// 1x1
+	a := New(&err, []int{-7})
+	assert.NilError(t, err)
+	assert.Equal(t, a.Determinant(&err), -7)
+	assert.NilError(t, err)
+
+	// 2x2
+	a = New(&err, []int{1, 2}, []int{3, 4})
+	assert.NilError(t, err)
+	assert.Equal(t, a.Determinant(&err), -2)
+	assert.NilError(t, err)
+
+	// 3x3 requiring a row swap
+	a = New(&err, []int{0, 2, 1}, []int{1, 0, 3}, []int{4, 1, 0})
+	assert.NilError(t, err)
+	assert.Equal(t, a.Determinant(&err), 25)
+	assert.NilError(t, err)
+
+	// 4x4
+	a = New(&err,
+		[]int{3, 2, 0, 1},
+		[]int{4, 0, 1, 2},
+		[]int{3, 0, 2, 1},
+		[]int{9, 2, 3, 1},
+	)
+	assert.NilError(t, err)
+	assert.Equal(t, a.Determinant(&err), 24)
+	assert.NilError(t, err)
+
+	// Singular
+	a = New(&err, []int{1, 2, 3}, []int{4, 5, 6}, []int{7, 8, 9})
+	assert.NilError(t, err)
+	assert.Equal(t, a.Determinant(&err), 0)
+	assert.NilError(t, err)
+
+	// Exact where the intermediate values do not fit in int8
+	b := New(&err, []int8{100, 99}, []int8{99, 98})
+	assert.NilError(t, err)
+	assert.Equal(t, b.Determinant(&err), int8(-1))
+	assert.NilError(t, err)
+
+	// Unsigned
+	c := New(&err, []uint{2, 1}, []uint{1, 2})
+	assert.NilError(t, err)
+	assert.Equal(t, c.Determinant(&err), uint(3))
+	assert.NilError(t, err)
+
+	// Float
+	d := New(&err, []float64{0.5, 2, 1}, []float64{1, 0, 3}, []float64{4, 1, 0})
+	assert.NilError(t, err)
+	det := d.Determinant(&err)
+	assert.NilError(t, err)
+	assert.Check(t, det > 23.4999 && det < 23.5001)
+}
+
+func TestDeterminantErrors(t *testing.T) {
+	var err error
+
+	a := New(&err, []int{1, 2, 3}, []int{4, 5, 6})
+	assert.NilError(t, err)
+	_ = a.Determinant(&err)
+	assert.ErrorContains(t, err, "cannot calculate the determinant of a non-square matrix")
+	err = nil
+
+	b := New(&err, []int8{100, -100}, []int8{100, 100})
+	assert.NilError(t, err)
+	_ = b.Determinant(&err)
+	assert.ErrorContains(t, err, "determinant overflows the matrix element type")
+	err = nil
+
+	c := New(&err, []uint8{1, 2}, []uint8{3, 4})
+	assert.NilError(t, err)
+	_ = c.Determinant(&err)
+	assert.ErrorContains(t, err, "determinant overflows the matrix element type")
+}
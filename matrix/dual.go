@@ -0,0 +1,303 @@
+package matrix
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Dual is a dual number, a value paired with its derivative with respect to a
+// single parameter. Arithmetic on dual numbers applies the rules of
+// differentiation, giving forward-mode automatic differentiation.
+// https://en.wikipedia.org/wiki/Automatic_differentiation#Automatic_differentiation_using_dual_numbers
+type Dual struct {
+	Value      float64
+	Derivative float64
+}
+
+// Constant returns a dual number with a derivative of zero.
+func Constant(x float64) Dual {
+	return Dual{Value: x}
+}
+
+// Variable returns a dual number with a derivative of one, the parameter being
+// differentiated with respect to.
+func Variable(x float64) Dual {
+	return Dual{Value: x, Derivative: 1}
+}
+
+// Add adds two dual numbers.
+func (a Dual) Add(b Dual) Dual {
+	return Dual{
+		Value:      a.Value + b.Value,
+		Derivative: a.Derivative + b.Derivative,
+	}
+}
+
+// Subtract subtracts a dual number from another one.
+func (a Dual) Subtract(b Dual) Dual {
+	return Dual{
+		Value:      a.Value - b.Value,
+		Derivative: a.Derivative - b.Derivative,
+	}
+}
+
+// Multiply multiplies two dual numbers using the product rule.
+func (a Dual) Multiply(b Dual) Dual {
+	return Dual{
+		Value:      a.Value * b.Value,
+		Derivative: a.Derivative*b.Value + a.Value*b.Derivative,
+	}
+}
+
+// Divide divides a dual number by another one using the quotient rule.
+func (a Dual) Divide(b Dual) Dual {
+	return Dual{
+		Value:      a.Value / b.Value,
+		Derivative: (a.Derivative*b.Value - a.Value*b.Derivative) / (b.Value * b.Value),
+	}
+}
+
+func (a Dual) String() string {
+	return fmt.Sprintf("%v+%vε", a.Value, a.Derivative)
+}
+
+// DualMatrix is a matrix of dual numbers, holding a matrix value and its
+// derivative with respect to a single parameter.
+type DualMatrix struct {
+	Dimensions Dimension
+	Values     [][]Dual
+}
+
+// NewDual instantiates a DualMatrix from a value and its derivative, which must
+// have the same dimensions.
+func NewDual(err *error, value, derivative Matrix[float64]) DualMatrix {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return DualMatrix{}
+	}
+
+	if value.Dimensions != derivative.Dimensions {
+		*err = errors.New("cannot create a dual Matrix due to incompatible dimensions")
+		return DualMatrix{}
+	}
+
+	values := make([][]Dual, value.Dimensions.Height)
+	for j := range values {
+		values[j] = make([]Dual, value.Dimensions.Width)
+		for i := range values[j] {
+			values[j][i] = Dual{Value: value.Values[j][i], Derivative: derivative.Values[j][i]}
+		}
+	}
+
+	return DualMatrix{
+		Dimensions: value.Dimensions,
+		Values:     values,
+	}
+}
+
+// Dual converts a Matrix to a DualMatrix of constants, with a derivative of zero.
+func (a Matrix[T]) Dual() DualMatrix {
+	values := make([][]Dual, a.Dimensions.Height)
+	for j := range values {
+		values[j] = make([]Dual, a.Dimensions.Width)
+		for i := range values[j] {
+			values[j][i] = Constant(float64(a.Values[j][i]))
+		}
+	}
+
+	return DualMatrix{
+		Dimensions: a.Dimensions,
+		Values:     values,
+	}
+}
+
+// Value returns the value of the matrix.
+func (a DualMatrix) Value() Matrix[float64] {
+	return a.part(func(d Dual) float64 { return d.Value })
+}
+
+// Derivative returns the derivative of the matrix.
+func (a DualMatrix) Derivative() Matrix[float64] {
+	return a.part(func(d Dual) float64 { return d.Derivative })
+}
+
+// MultiplyScalar multiplies the Matrix by a scalar.
+func (a DualMatrix) MultiplyScalar(err *error, x Dual) DualMatrix {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return DualMatrix{}
+	}
+
+	m := a.Clone()
+	for j := 0; j < a.Dimensions.Height; j++ {
+		for i := 0; i < a.Dimensions.Width; i++ {
+			m.Values[j][i] = m.Values[j][i].Multiply(x)
+		}
+	}
+	return m
+}
+
+// Multiply multiplies the matrix by another matrix.
+// The height of matix B must match the width of matrix A.
+func (a DualMatrix) Multiply(err *error, b DualMatrix) DualMatrix {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return DualMatrix{}
+	}
+
+	// Check matrices can be multiplied.
+	if a.Dimensions.Width != b.Dimensions.Height {
+		*err = errors.New("cannot multiply matrices due to incompatible dimensions")
+		return DualMatrix{}
+	}
+
+	width := b.Dimensions.Width
+	height := a.Dimensions.Height
+	m := make([][]Dual, height)
+	for j := 0; j < height; j++ {
+		m[j] = make([]Dual, width)
+		for x := 0; x < width; x++ {
+			sum := Dual{}
+			for i := 0; i < a.Dimensions.Width; i++ {
+				sum = sum.Add(a.Values[j][i].Multiply(b.Values[i][x]))
+			}
+			m[j][x] = sum
+		}
+	}
+
+	return DualMatrix{
+		Dimensions: Dimension{
+			Width:  width,
+			Height: height,
+		},
+		Values: m,
+	}
+}
+
+// Add a matrix to another one.
+// The dimensions of the matrices must match.
+func (a DualMatrix) Add(err *error, b DualMatrix) DualMatrix {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return DualMatrix{}
+	}
+
+	// Check matrices can be added.
+	if a.Dimensions != b.Dimensions {
+		*err = errors.New("cannot add matrices due to incompatible dimensions")
+		return DualMatrix{}
+	}
+
+	m := a.Clone()
+	for j := 0; j < a.Dimensions.Height; j++ {
+		for i := 0; i < a.Dimensions.Width; i++ {
+			m.Values[j][i] = m.Values[j][i].Add(b.Values[j][i])
+		}
+	}
+	return m
+}
+
+// Subtract a matrix from another one.
+// The dimensions of the matrices must match.
+func (a DualMatrix) Subtract(err *error, b DualMatrix) DualMatrix {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return DualMatrix{}
+	}
+
+	// Check matrices can be subtracted.
+	if a.Dimensions != b.Dimensions {
+		*err = errors.New("cannot subtract matrices due to incompatible dimensions")
+		return DualMatrix{}
+	}
+
+	m := a.Clone()
+	for j := 0; j < a.Dimensions.Height; j++ {
+		for i := 0; i < a.Dimensions.Width; i++ {
+			m.Values[j][i] = m.Values[j][i].Subtract(b.Values[j][i])
+		}
+	}
+	return m
+}
+
+// Inverse a matrix, with the derivative -A⁻¹ A' A⁻¹.
+func (a DualMatrix) Inverse(err *error) DualMatrix {
+	// Avoid hiding previous errors.
+	if *err != nil {
+		return DualMatrix{}
+	}
+
+	inverse := a.Value().Inverse(err)
+	derivative := inverse.Multiply(err, a.Derivative()).Multiply(err, inverse).MultiplyScalar(err, -1)
+	return NewDual(err, inverse, derivative)
+}
+
+// Transpose calculates the transpose of a Matrix.
+func (a DualMatrix) Transpose(err *error) DualMatrix {
+	values := make([][]Dual, a.Dimensions.Width)
+	for j := 0; j < a.Dimensions.Width; j++ {
+		values[j] = make([]Dual, a.Dimensions.Height)
+		for i := 0; i < a.Dimensions.Height; i++ {
+			values[j][i] = a.Values[i][j]
+		}
+	}
+
+	return DualMatrix{
+		Dimensions: Dimension{
+			Width:  a.Dimensions.Height,
+			Height: a.Dimensions.Width,
+		},
+		Values: values,
+	}
+}
+
+func (a DualMatrix) Clone() DualMatrix {
+	values := make([][]Dual, a.Dimensions.Height)
+	for j := 0; j < a.Dimensions.Height; j++ {
+		values[j] = make([]Dual, a.Dimensions.Width)
+		copy(values[j], a.Values[j])
+	}
+
+	return DualMatrix{
+		Dimensions: a.Dimensions,
+		Values:     values,
+	}
+}
+
+func (a DualMatrix) Equal(b DualMatrix) bool {
+	if a.Dimensions != b.Dimensions {
+		return false
+	}
+	for j := 0; j < a.Dimensions.Height; j++ {
+		for i := 0; i < a.Dimensions.Width; i++ {
+			if a.Values[j][i] != b.Values[j][i] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (a DualMatrix) ApproxEqual(b DualMatrix, errorMargin float64) bool {
+	return a.Value().ApproxEqual(b.Value(), errorMargin) && a.Derivative().ApproxEqual(b.Derivative(), errorMargin)
+}
+
+func (a DualMatrix) String() string {
+	return fmt.Sprint(a.Values)
+}
+
+// part returns the matrix of one part of each dual number.
+func (a DualMatrix) part(f func(Dual) float64) Matrix[float64] {
+	values := make([][]float64, a.Dimensions.Height)
+	for j := range values {
+		values[j] = make([]float64, a.Dimensions.Width)
+		for i := range values[j] {
+			values[j][i] = f(a.Values[j][i])
+		}
+	}
+
+	return Matrix[float64]{
+		Dimensions: a.Dimensions,
+		Values:     values,
+	}
+}
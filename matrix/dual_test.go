@@ -0,0 +1,132 @@
+package matrix
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestDual(t *testing.T) {
+	a := Variable(3)
+	b := Constant(2)
+
+	assert.Equal(t, a.Add(b), Dual{Value: 5, Derivative: 1})
+	assert.Equal(t, a.Subtract(b), Dual{Value: 1, Derivative: 1})
+	assert.Equal(t, a.Multiply(a), Dual{Value: 9, Derivative: 6})
+	assert.Equal(t, b.Divide(a), Dual{Value: 2.0 / 3, Derivative: -2.0 / 9})
+	assert.Equal(t, a.String(), "3+1ε")
+}
+
+func TestNewDual(t *testing.T) {
+	var err error
+
+	v := New(&err, []float64{1, 2}, []float64{3, 4})
+	d := New(&err, []float64{0, 1}, []float64{0, 0})
+	assert.NilError(t, err)
+
+	a := NewDual(&err, v, d)
+	assert.NilError(t, err)
+	assert.Check(t, a.Value().Equal(v))
+	assert.Check(t, a.Derivative().Equal(d))
+	assert.Equal(t, a.Values[0][1], Dual{Value: 2, Derivative: 1})
+
+	c := New(&err, []int{1, 2}).Dual()
+	assert.NilError(t, err)
+	assert.Equal(t, c.String(), "[[1+0ε 2+0ε]]")
+
+	_ = NewDual(&err, v, New(&err, []float64{1, 2}))
+	assert.ErrorContains(t, err, "cannot create a dual Matrix due to incompatible dimensions")
+}
+
+func TestDualChain(t *testing.T) {
+	var err error
+
+	// f(t) = (2 A(t) B)ᵀ with A(t) = [[t, 1], [t², 0]] at t = 3
+	f := func(p float64) Matrix[float64] {
+		a := New(&err, []float64{p, 1}, []float64{p * p, 0})
+		b := New(&err, []float64{1, 2, 0}, []float64{-1, 0, 4})
+		return a.MultiplyScalar(&err, 2).Multiply(&err, b).Transpose(&err)
+	}
+
+	v := New(&err, []float64{3, 1}, []float64{9, 0})
+	d := New(&err, []float64{1, 0}, []float64{6, 0})
+	a := NewDual(&err, v, d)
+	b := New(&err, []float64{1, 2, 0}, []float64{-1, 0, 4}).Dual()
+	m := a.MultiplyScalar(&err, Constant(2)).Multiply(&err, b).Transpose(&err)
+	assert.NilError(t, err)
+
+	assert.Check(t, m.Value().Equal(f(3)))
+	const h = 1e-6
+	diff := f(3+h).Subtract(&err, f(3-h)).MultiplyScalar(&err, 1/(2*h))
+	assert.NilError(t, err)
+	assert.Check(t, m.Derivative().ApproxEqual(diff, 1e-6))
+	r := New(&err, []float64{2, 12}, []float64{4, 24}, []float64{0, 0})
+	assert.NilError(t, err)
+	assert.Check(t, m.Derivative().ApproxEqual(r, 1e-12))
+
+	// Differentiating with respect to the scalar instead
+	c := v.Dual().MultiplyScalar(&err, Variable(2))
+	assert.NilError(t, err)
+	assert.Check(t, c.Derivative().Equal(v))
+}
+
+func TestDualArithmetic(t *testing.T) {
+	var err error
+
+	a := NewDual(&err,
+		New(&err, []float64{1, 2}, []float64{3, 4}),
+		New(&err, []float64{1, 0}, []float64{0, 1}),
+	)
+	assert.NilError(t, err)
+	b := New(&err, []float64{5, 6}, []float64{7, 8}).Dual()
+	assert.NilError(t, err)
+
+	m := a.Add(&err, b)
+	assert.NilError(t, err)
+	assert.Equal(t, m.String(), "[[6+1ε 8+0ε] [10+0ε 12+1ε]]")
+
+	m = a.Subtract(&err, b)
+	assert.NilError(t, err)
+	assert.Equal(t, m.String(), "[[-4+1ε -4+0ε] [-4+0ε -4+1ε]]")
+
+	m = a.Multiply(&err, a)
+	assert.NilError(t, err)
+	// (A + εI)² = A² + 2εA
+	assert.Equal(t, m.String(), "[[7+2ε 10+4ε] [15+6ε 22+8ε]]")
+
+	c := New(&err, []float64{1, 2, 3}).Dual()
+	assert.NilError(t, err)
+	_ = c.Multiply(&err, a)
+	assert.ErrorContains(t, err, "cannot multiply matrices due to incompatible dimensions")
+	err = nil
+
+	_ = c.Add(&err, a)
+	assert.ErrorContains(t, err, "cannot add matrices due to incompatible dimensions")
+}
+
+func TestDualInverse(t *testing.T) {
+	var err error
+
+	f := func(p float64) Matrix[float64] {
+		return New(&err, []float64{p, 2}, []float64{1, p * p}).Inverse(&err)
+	}
+
+	a := NewDual(&err,
+		New(&err, []float64{2, 2}, []float64{1, 4}),
+		New(&err, []float64{1, 0}, []float64{0, 4}),
+	)
+	assert.NilError(t, err)
+	m := a.Inverse(&err)
+	assert.NilError(t, err)
+
+	assert.Check(t, m.Value().ApproxEqual(f(2), 1e-12))
+	const h = 1e-6
+	diff := f(2+h).Subtract(&err, f(2-h)).MultiplyScalar(&err, 1/(2*h))
+	assert.NilError(t, err)
+	assert.Check(t, m.Derivative().ApproxEqual(diff, 1e-6))
+
+	s := New(&err, []float64{1, 2}, []float64{2, 4}).Dual()
+	assert.NilError(t, err)
+	_ = s.Inverse(&err)
+	assert.ErrorContains(t, err, "cannot invert, matrix is singular")
+}
@@ -0,0 +1,687 @@
+package matrix
+
+import (
+	"errors"
+	"math"
+	"math/cmplx"
+	"sort"
+
+	"golang.org/x/exp/constraints"
+)
+
+// SymmetricEigen is the eigendecomposition of a symmetric matrix, such that
+// A = Vectors * diag(Values) * Vectors'. The eigenvectors are floating point
+// for every element type.
+type SymmetricEigen[T constraints.Integer | constraints.Float] struct {
+	// Values holds the eigenvalues in ascending order.
+	Values []float64
+	// Vectors holds the orthonormal eigenvectors as columns, in the same order
+	// as Values.
+	Vectors Matrix[float64]
+}
+
+// SymmetricEigen calculates the eigenvalues and eigenvectors of a symmetric
+// matrix using the cyclic Jacobi method.
+// https://en.wikipedia.org/wiki/Jacobi_eigenvalue_algorithm
+func (a Matrix[T]) SymmetricEigen(err *error) SymmetricEigen[T] {
+	// Avoid hiding previous errors.
+	if *err != nil {
+		return SymmetricEigen[T]{}
+	}
+
+	values := a.float64Values()
+	if a.Dimensions.Height != a.Dimensions.Width {
+		*err = errors.New("cannot calculate the eigendecomposition of a non-square matrix")
+		return SymmetricEigen[T]{}
+	}
+	if !a.isFinite() {
+		*err = errors.New("cannot calculate the eigendecomposition of a matrix with non-finite values")
+		return SymmetricEigen[T]{}
+	}
+	if !isSymmetric(values) {
+		*err = errors.New("cannot calculate the symmetric eigendecomposition, matrix is not symmetric")
+		return SymmetricEigen[T]{}
+	}
+
+	d, v, ok := jacobiEigen(values)
+	if !ok {
+		*err = errors.New("cannot calculate the eigendecomposition, Jacobi iteration did not converge")
+		return SymmetricEigen[T]{}
+	}
+
+	n := len(d)
+	order := make([]int, n)
+	for j := 0; j < n; j++ {
+		order[j] = j
+	}
+	sort.SliceStable(order, func(x, y int) bool {
+		return d[order[x]] < d[order[y]]
+	})
+
+	sorted := make([]float64, n)
+	vectors := make([][]float64, n)
+	for j := 0; j < n; j++ {
+		vectors[j] = make([]float64, n)
+	}
+	for k, i := range order {
+		sorted[k] = d[i]
+		for j := 0; j < n; j++ {
+			vectors[j][k] = v[j][i]
+		}
+	}
+
+	return SymmetricEigen[T]{
+		Values:  sorted,
+		Vectors: fromFloat64[float64](vectors),
+	}
+}
+
+// jacobiEigen diagonalizes the symmetric matrix a in place with plane rotations,
+// returning the unsorted eigenvalues and the eigenvectors as columns, and
+// whether the iteration converged.
+func jacobiEigen(a [][]float64) ([]float64, [][]float64, bool) {
+	n := len(a)
+	v := identity(n)
+
+	const eps = 1e-15
+	converged := false
+	for sweep := 0; sweep < 100; sweep++ {
+		off, diag := 0.0, 0.0
+		for j := 0; j < n; j++ {
+			diag += a[j][j] * a[j][j]
+			for i := j + 1; i < n; i++ {
+				off += a[j][i] * a[j][i]
+			}
+		}
+		if off <= eps*eps*diag || off == 0 {
+			converged = true
+			break
+		}
+
+		for p := 0; p < n-1; p++ {
+			for q := p + 1; q < n; q++ {
+				if a[p][q] == 0 {
+					continue
+				}
+
+				// Choose the rotation that zeroes a[p][q].
+				theta := (a[q][q] - a[p][p]) / (2 * a[p][q])
+				t := math.Copysign(1, theta) / (math.Abs(theta) + math.Sqrt(theta*theta+1))
+				c := 1 / math.Sqrt(t*t+1)
+				s := t * c
+
+				apq := a[p][q]
+				a[p][p] -= t * apq
+				a[q][q] += t * apq
+				a[p][q], a[q][p] = 0, 0
+				for r := 0; r < n; r++ {
+					if r != p && r != q {
+						arp, arq := a[r][p], a[r][q]
+						a[r][p] = c*arp - s*arq
+						a[p][r] = a[r][p]
+						a[r][q] = s*arp + c*arq
+						a[q][r] = a[r][q]
+					}
+					vrp, vrq := v[r][p], v[r][q]
+					v[r][p] = c*vrp - s*vrq
+					v[r][q] = s*vrp + c*vrq
+				}
+			}
+		}
+	}
+
+	d := make([]float64, n)
+	for j := 0; j < n; j++ {
+		d[j] = a[j][j]
+	}
+	return d, v, converged
+}
+
+// Eigen is the eigendecomposition of a general real square matrix.
+type Eigen struct {
+	// Values holds the eigenvalues. Complex eigenvalues appear as adjacent
+	// conjugate pairs.
+	Values []complex128
+	// Vectors holds the eigenvectors as columns with unit length, in the same
+	// order as Values.
+	Vectors ComplexMatrix[complex128]
+}
+
+// Eigen calculates the eigenvalues and eigenvectors of a square matrix by
+// reducing it to Hessenberg form and applying the shifted QR algorithm.
+// The algorithm follows the EISPACK routines orthes and hqr2.
+// https://en.wikipedia.org/wiki/QR_algorithm
+func (a Matrix[T]) Eigen(err *error) Eigen {
+	// Avoid hiding previous errors.
+	if *err != nil {
+		return Eigen{}
+	}
+
+	// Check the matrix is square.
+	if a.Dimensions.Height != a.Dimensions.Width {
+		*err = errors.New("cannot calculate the eigendecomposition of a non-square matrix")
+		return Eigen{}
+	}
+	if !a.isFinite() {
+		*err = errors.New("cannot calculate the eigendecomposition of a matrix with non-finite values")
+		return Eigen{}
+	}
+
+	n := a.Dimensions.Width
+	h := a.float64Values()
+	v := hessenberg(h)
+	d := make([]float64, n)
+	e := make([]float64, n)
+	if !hqr2(h, v, d, e) {
+		*err = errors.New("cannot calculate the eigendecomposition, QR iteration did not converge")
+		return Eigen{}
+	}
+
+	// Complex pairs are stored with the real part of the eigenvector in the
+	// first column and the imaginary part in the second.
+	values := make([]complex128, n)
+	vectors := make([][]complex128, n)
+	for j := 0; j < n; j++ {
+		vectors[j] = make([]complex128, n)
+	}
+	for k := 0; k < n; k++ {
+		values[k] = complex(d[k], e[k])
+		for j := 0; j < n; j++ {
+			switch {
+			case e[k] > 0:
+				vectors[j][k] = complex(v[j][k], v[j][k+1])
+			case e[k] < 0:
+				vectors[j][k] = complex(v[j][k-1], -v[j][k])
+			default:
+				vectors[j][k] = complex(v[j][k], 0)
+			}
+		}
+	}
+
+	// Normalize the eigenvectors.
+	for k := 0; k < n; k++ {
+		norm := 0.0
+		for j := 0; j < n; j++ {
+			norm = math.Hypot(norm, cmplx.Abs(vectors[j][k]))
+		}
+		if norm == 0 {
+			continue
+		}
+		for j := 0; j < n; j++ {
+			vectors[j][k] /= complex(norm, 0)
+		}
+	}
+
+	return Eigen{
+		Values:  values,
+		Vectors: fromComplex128[complex128](vectors),
+	}
+}
+
+// hessenberg reduces h to upper Hessenberg form in place with Householder
+// similarity transformations, returning the accumulated transformations.
+func hessenberg(h [][]float64) [][]float64 {
+	n := len(h)
+	high := n - 1
+	ort := make([]float64, n)
+
+	for m := 1; m < high; m++ {
+		scale := 0.0
+		for i := m; i <= high; i++ {
+			scale += math.Abs(h[i][m-1])
+		}
+		if scale == 0 {
+			continue
+		}
+
+		// Compute the Householder transformation.
+		sum := 0.0
+		for i := high; i >= m; i-- {
+			ort[i] = h[i][m-1] / scale
+			sum += ort[i] * ort[i]
+		}
+		g := math.Sqrt(sum)
+		if ort[m] > 0 {
+			g = -g
+		}
+		sum -= ort[m] * g
+		ort[m] -= g
+
+		// Apply the similarity transformation H = (I - u*u'/h) * H * (I - u*u'/h).
+		for j := m; j < n; j++ {
+			f := 0.0
+			for i := high; i >= m; i-- {
+				f += ort[i] * h[i][j]
+			}
+			f /= sum
+			for i := m; i <= high; i++ {
+				h[i][j] -= f * ort[i]
+			}
+		}
+		for i := 0; i <= high; i++ {
+			f := 0.0
+			for j := high; j >= m; j-- {
+				f += ort[j] * h[i][j]
+			}
+			f /= sum
+			for j := m; j <= high; j++ {
+				h[i][j] -= f * ort[j]
+			}
+		}
+		ort[m] *= scale
+		h[m][m-1] = scale * g
+	}
+
+	// Accumulate the transformations.
+	v := identity(n)
+	for m := high - 1; m >= 1; m-- {
+		if h[m][m-1] == 0 {
+			continue
+		}
+		for i := m + 1; i <= high; i++ {
+			ort[i] = h[i][m-1]
+		}
+		for j := m; j <= high; j++ {
+			g := 0.0
+			for i := m; i <= high; i++ {
+				g += ort[i] * v[i][j]
+			}
+			// Double division avoids possible underflow.
+			g = (g / ort[m]) / h[m][m-1]
+			for i := m; i <= high; i++ {
+				v[i][j] += g * ort[i]
+			}
+		}
+	}
+	return v
+}
+
+// hqr2 reduces the Hessenberg matrix h to real Schur form with the shifted QR
+// algorithm, storing the real and imaginary parts of the eigenvalues in d and e
+// and replacing v with the eigenvectors. It reports whether the iteration
+// converged.
+func hqr2(h, v [][]float64, d, e []float64) bool {
+	nn := len(h)
+	n := nn - 1
+	eps := math.Pow(2, -52)
+	exshift := 0.0
+	var p, q, r, s, z, t, w, x, y float64
+
+	norm := 0.0
+	for i := 0; i < nn; i++ {
+		for j := i - 1; j < nn; j++ {
+			if j >= 0 {
+				norm += math.Abs(h[i][j])
+			}
+		}
+	}
+
+	iter := 0
+	for n >= 0 {
+		// Look for a single small sub-diagonal element.
+		l := n
+		for l > 0 {
+			s = math.Abs(h[l-1][l-1]) + math.Abs(h[l][l])
+			if s == 0 {
+				s = norm
+			}
+			if math.Abs(h[l][l-1]) < eps*s {
+				break
+			}
+			l--
+		}
+
+		if l == n {
+			// One root found.
+			h[n][n] += exshift
+			d[n] = h[n][n]
+			e[n] = 0
+			n--
+			iter = 0
+		} else if l == n-1 {
+			// Two roots found.
+			w = h[n][n-1] * h[n-1][n]
+			p = (h[n-1][n-1] - h[n][n]) / 2
+			q = p*p + w
+			z = math.Sqrt(math.Abs(q))
+			h[n][n] += exshift
+			h[n-1][n-1] += exshift
+			x = h[n][n]
+
+			if q >= 0 {
+				// Real pair.
+				if p >= 0 {
+					z = p + z
+				} else {
+					z = p - z
+				}
+				d[n-1] = x + z
+				d[n] = d[n-1]
+				if z != 0 {
+					d[n] = x - w/z
+				}
+				e[n-1] = 0
+				e[n] = 0
+				x = h[n][n-1]
+				s = math.Abs(x) + math.Abs(z)
+				p = x / s
+				q = z / s
+				r = math.Sqrt(p*p + q*q)
+				p /= r
+				q /= r
+
+				// Row modification.
+				for j := n - 1; j < nn; j++ {
+					z = h[n-1][j]
+					h[n-1][j] = q*z + p*h[n][j]
+					h[n][j] = q*h[n][j] - p*z
+				}
+
+				// Column modification.
+				for i := 0; i <= n; i++ {
+					z = h[i][n-1]
+					h[i][n-1] = q*z + p*h[i][n]
+					h[i][n] = q*h[i][n] - p*z
+				}
+
+				// Accumulate transformations.
+				for i := 0; i < nn; i++ {
+					z = v[i][n-1]
+					v[i][n-1] = q*z + p*v[i][n]
+					v[i][n] = q*v[i][n] - p*z
+				}
+			} else {
+				// Complex pair.
+				d[n-1] = x + p
+				d[n] = x + p
+				e[n-1] = z
+				e[n] = -z
+			}
+			n -= 2
+			iter = 0
+		} else {
+			// No convergence yet, so form a shift.
+			x = h[n][n]
+			y = 0
+			w = 0
+			if l < n {
+				y = h[n-1][n-1]
+				w = h[n][n-1] * h[n-1][n]
+			}
+
+			// Wilkinson's original ad hoc shift.
+			if iter == 10 {
+				exshift += x
+				for i := 0; i <= n; i++ {
+					h[i][i] -= x
+				}
+				s = math.Abs(h[n][n-1]) + math.Abs(h[n-1][n-2])
+				x = 0.75 * s
+				y = x
+				w = -0.4375 * s * s
+			}
+
+			// MATLAB's ad hoc shift.
+			if iter == 30 {
+				s = (y - x) / 2
+				s = s*s + w
+				if s > 0 {
+					s = math.Sqrt(s)
+					if y < x {
+						s = -s
+					}
+					s = x - w/((y-x)/2+s)
+					for i := 0; i <= n; i++ {
+						h[i][i] -= s
+					}
+					exshift += s
+					x = 0.964
+					y = x
+					w = x
+				}
+			}
+
+			iter++
+			if iter > 100 {
+				return false
+			}
+
+			// Look for two consecutive small sub-diagonal elements.
+			m := n - 2
+			for m >= l {
+				z = h[m][m]
+				r = x - z
+				s = y - z
+				p = (r*s-w)/h[m+1][m] + h[m][m+1]
+				q = h[m+1][m+1] - z - r - s
+				r = h[m+2][m+1]
+				s = math.Abs(p) + math.Abs(q) + math.Abs(r)
+				p /= s
+				q /= s
+				r /= s
+				if m == l {
+					break
+				}
+				if math.Abs(h[m][m-1])*(math.Abs(q)+math.Abs(r)) <
+					eps*(math.Abs(p)*(math.Abs(h[m-1][m-1])+math.Abs(z)+math.Abs(h[m+1][m+1]))) {
+					break
+				}
+				m--
+			}
+
+			for i := m + 2; i <= n; i++ {
+				h[i][i-2] = 0
+				if i > m+2 {
+					h[i][i-3] = 0
+				}
+			}
+
+			// Double QR step involving rows l:n and columns m:n.
+			for k := m; k <= n-1; k++ {
+				notlast := k != n-1
+				if k != m {
+					p = h[k][k-1]
+					q = h[k+1][k-1]
+					r = 0
+					if notlast {
+						r = h[k+2][k-1]
+					}
+					x = math.Abs(p) + math.Abs(q) + math.Abs(r)
+					if x == 0 {
+						continue
+					}
+					p /= x
+					q /= x
+					r /= x
+				}
+
+				s = math.Sqrt(p*p + q*q + r*r)
+				if p < 0 {
+					s = -s
+				}
+				if s == 0 {
+					continue
+				}
+				if k != m {
+					h[k][k-1] = -s * x
+				} else if l != m {
+					h[k][k-1] = -h[k][k-1]
+				}
+				p += s
+				x = p / s
+				y = q / s
+				z = r / s
+				q /= p
+				r /= p
+
+				// Row modification.
+				for j := k; j < nn; j++ {
+					p = h[k][j] + q*h[k+1][j]
+					if notlast {
+						p += r * h[k+2][j]
+						h[k+2][j] -= p * z
+					}
+					h[k][j] -= p * x
+					h[k+1][j] -= p * y
+				}
+
+				// Column modification.
+				for i := 0; i <= n && i <= k+3; i++ {
+					p = x*h[i][k] + y*h[i][k+1]
+					if notlast {
+						p += z * h[i][k+2]
+						h[i][k+2] -= p * r
+					}
+					h[i][k] -= p
+					h[i][k+1] -= p * q
+				}
+
+				// Accumulate transformations.
+				for i := 0; i < nn; i++ {
+					p = x*v[i][k] + y*v[i][k+1]
+					if notlast {
+						p += z * v[i][k+2]
+						v[i][k+2] -= p * r
+					}
+					v[i][k] -= p
+					v[i][k+1] -= p * q
+				}
+			}
+		}
+	}
+
+	// Back substitute to find the vectors of the upper triangular form.
+	if norm == 0 {
+		return true
+	}
+
+	for n = nn - 1; n >= 0; n-- {
+		p = d[n]
+		q = e[n]
+
+		if q == 0 {
+			// Real vector.
+			l := n
+			h[n][n] = 1
+			for i := n - 1; i >= 0; i-- {
+				w = h[i][i] - p
+				r = 0
+				for j := l; j <= n; j++ {
+					r += h[i][j] * h[j][n]
+				}
+				if e[i] < 0 {
+					z = w
+					s = r
+					continue
+				}
+
+				l = i
+				if e[i] == 0 {
+					if w != 0 {
+						h[i][n] = -r / w
+					} else {
+						h[i][n] = -r / (eps * norm)
+					}
+				} else {
+					// Solve real equations.
+					x = h[i][i+1]
+					y = h[i+1][i]
+					q = (d[i]-p)*(d[i]-p) + e[i]*e[i]
+					t = (x*s - z*r) / q
+					h[i][n] = t
+					if math.Abs(x) > math.Abs(z) {
+						h[i+1][n] = (-r - w*t) / x
+					} else {
+						h[i+1][n] = (-s - y*t) / z
+					}
+				}
+
+				// Overflow control.
+				t = math.Abs(h[i][n])
+				if (eps*t)*t > 1 {
+					for j := i; j <= n; j++ {
+						h[j][n] /= t
+					}
+				}
+			}
+		} else if q < 0 {
+			// Complex vector.
+			l := n - 1
+
+			// The last vector component is imaginary, so the matrix is triangular.
+			if math.Abs(h[n][n-1]) > math.Abs(h[n-1][n]) {
+				h[n-1][n-1] = q / h[n][n-1]
+				h[n-1][n] = -(h[n][n] - p) / h[n][n-1]
+			} else {
+				c := complex(0, -h[n-1][n]) / complex(h[n-1][n-1]-p, q)
+				h[n-1][n-1] = real(c)
+				h[n-1][n] = imag(c)
+			}
+			h[n][n-1] = 0
+			h[n][n] = 1
+			for i := n - 2; i >= 0; i-- {
+				ra, sa := 0.0, 0.0
+				for j := l; j <= n; j++ {
+					ra += h[i][j] * h[j][n-1]
+					sa += h[i][j] * h[j][n]
+				}
+				w = h[i][i] - p
+
+				if e[i] < 0 {
+					z = w
+					r = ra
+					s = sa
+					continue
+				}
+
+				l = i
+				if e[i] == 0 {
+					c := complex(-ra, -sa) / complex(w, q)
+					h[i][n-1] = real(c)
+					h[i][n] = imag(c)
+				} else {
+					// Solve complex equations.
+					x = h[i][i+1]
+					y = h[i+1][i]
+					vr := (d[i]-p)*(d[i]-p) + e[i]*e[i] - q*q
+					vi := (d[i] - p) * 2 * q
+					if vr == 0 && vi == 0 {
+						vr = eps * norm * (math.Abs(w) + math.Abs(q) + math.Abs(x) + math.Abs(y) + math.Abs(z))
+					}
+					c := complex(x*r-z*ra+q*sa, x*s-z*sa-q*ra) / complex(vr, vi)
+					h[i][n-1] = real(c)
+					h[i][n] = imag(c)
+					if math.Abs(x) > math.Abs(z)+math.Abs(q) {
+						h[i+1][n-1] = (-ra - w*h[i][n-1] + q*h[i][n]) / x
+						h[i+1][n] = (-sa - w*h[i][n] - q*h[i][n-1]) / x
+					} else {
+						c := complex(-r-y*h[i][n-1], -s-y*h[i][n]) / complex(z, q)
+						h[i+1][n-1] = real(c)
+						h[i+1][n] = imag(c)
+					}
+				}
+
+				// Overflow control.
+				t = math.Max(math.Abs(h[i][n-1]), math.Abs(h[i][n]))
+				if (eps*t)*t > 1 {
+					for j := i; j <= n; j++ {
+						h[j][n-1] /= t
+						h[j][n] /= t
+					}
+				}
+			}
+		}
+	}
+
+	// Back transform to get the eigenvectors of the original matrix.
+	for j := nn - 1; j >= 0; j-- {
+		for i := 0; i < nn; i++ {
+			z = 0
+			for k := 0; k <= j; k++ {
+				z += v[i][k] * h[k][j]
+			}
+			v[i][j] = z
+		}
+	}
+	return true
+}
@@ -0,0 +1,212 @@
+package matrix
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func checkSymmetricEigen(t *testing.T, a Matrix[float64], f SymmetricEigen[float64]) {
+	t.Helper()
+	var err error
+
+	// A * V = V * D
+	d := NewZero[float64](&err, a.Dimensions)
+	assert.NilError(t, err)
+	for j, v := range f.Values {
+		d.Values[j][j] = v
+	}
+	av := a.Multiply(&err, f.Vectors)
+	assert.NilError(t, err)
+	vd := f.Vectors.Multiply(&err, d)
+	assert.NilError(t, err)
+	assert.Check(t, av.ApproxEqual(vd, 0.0001))
+
+	// V' * V = I
+	vv := f.Vectors.Transpose(&err).Multiply(&err, f.Vectors)
+	assert.NilError(t, err)
+	i := NewIdentity[float64](&err, a.Dimensions)
+	assert.NilError(t, err)
+	assert.Check(t, vv.ApproxEqual(i, 0.0001))
+
+	// Values are ascending.
+	for k := 1; k < len(f.Values); k++ {
+		assert.Check(t, f.Values[k] >= f.Values[k-1])
+	}
+}
+
+func TestSymmetricEigen(t *testing.T) {
+	var err error
+
+	// 2x2
+	a := New(&err, []float64{2, 1}, []float64{1, 2})
+	assert.NilError(t, err)
+	f := a.SymmetricEigen(&err)
+	assert.NilError(t, err)
+	checkSymmetricEigen(t, a, f)
+	assert.Check(t, math.Abs(f.Values[0]-1) < 0.0001)
+	assert.Check(t, math.Abs(f.Values[1]-3) < 0.0001)
+
+	// 3x3
+	a = New(&err, []float64{2, -1, 0}, []float64{-1, 2, -1}, []float64{0, -1, 2})
+	assert.NilError(t, err)
+	f = a.SymmetricEigen(&err)
+	assert.NilError(t, err)
+	checkSymmetricEigen(t, a, f)
+	assert.Check(t, math.Abs(f.Values[0]-(2-math.Sqrt2)) < 0.0001)
+	assert.Check(t, math.Abs(f.Values[1]-2) < 0.0001)
+	assert.Check(t, math.Abs(f.Values[2]-(2+math.Sqrt2)) < 0.0001)
+
+	// 4x4 with repeated and negative eigenvalues
+	a = New(&err,
+		[]float64{1, 2, 0, 0},
+		[]float64{2, 1, 0, 0},
+		[]float64{0, 0, 3, 0},
+		[]float64{0, 0, 0, 3},
+	)
+	assert.NilError(t, err)
+	f = a.SymmetricEigen(&err)
+	assert.NilError(t, err)
+	checkSymmetricEigen(t, a, f)
+	assert.Check(t, math.Abs(f.Values[0]+1) < 0.0001)
+
+	// Diagonal
+	a = New(&err, []float64{5, 0}, []float64{0, -2})
+	assert.NilError(t, err)
+	f = a.SymmetricEigen(&err)
+	assert.NilError(t, err)
+	checkSymmetricEigen(t, a, f)
+
+	// Integer matrices have floating point eigenvectors.
+	b := New(&err, []int{2, 1}, []int{1, 2})
+	assert.NilError(t, err)
+	g := b.SymmetricEigen(&err)
+	assert.NilError(t, err)
+	checkSymmetricEigen(t, New(&err, []float64{2, 1}, []float64{1, 2}), SymmetricEigen[float64](g))
+	assert.Check(t, math.Abs(math.Abs(g.Vectors.Values[0][0])-math.Sqrt2/2) < 1e-12)
+}
+
+func TestSymmetricEigenErrors(t *testing.T) {
+	var err error
+
+	a := New(&err, []float64{1, 2, 3}, []float64{4, 5, 6})
+	assert.NilError(t, err)
+	_ = a.SymmetricEigen(&err)
+	assert.ErrorContains(t, err, "cannot calculate the eigendecomposition of a non-square matrix")
+	err = nil
+
+	a = New(&err, []float64{1, 2}, []float64{3, 4})
+	assert.NilError(t, err)
+	_ = a.SymmetricEigen(&err)
+	assert.ErrorContains(t, err, "cannot calculate the symmetric eigendecomposition, matrix is not symmetric")
+	err = nil
+
+	a = New(&err, []float64{1, math.NaN()}, []float64{math.NaN(), 1})
+	assert.NilError(t, err)
+	_ = a.SymmetricEigen(&err)
+	assert.ErrorContains(t, err, "cannot calculate the eigendecomposition of a matrix with non-finite values")
+}
+
+func checkEigen(t *testing.T, a Matrix[float64], f Eigen) {
+	t.Helper()
+
+	// A * v = lambda * v for each eigenpair.
+	n := a.Dimensions.Width
+	assert.Equal(t, len(f.Values), n)
+	for k := 0; k < n; k++ {
+		norm := 0.0
+		for j := 0; j < n; j++ {
+			av := complex(0, 0)
+			for i := 0; i < n; i++ {
+				av += complex(a.Values[j][i], 0) * f.Vectors.Values[i][k]
+			}
+			assert.Check(t, cmplx.Abs(av-f.Values[k]*f.Vectors.Values[j][k]) < 0.0001)
+			norm = math.Hypot(norm, cmplx.Abs(f.Vectors.Values[j][k]))
+		}
+		assert.Check(t, math.Abs(norm-1) < 0.0001)
+	}
+}
+
+func TestEigen(t *testing.T) {
+	var err error
+
+	// Rotation with eigenvalues ±i
+	a := New(&err, []float64{0, -1}, []float64{1, 0})
+	assert.NilError(t, err)
+	f := a.Eigen(&err)
+	assert.NilError(t, err)
+	checkEigen(t, a, f)
+	assert.Check(t, cmplx.Abs(f.Values[0]-f.Values[1]) > 1)
+	assert.Check(t, math.Abs(real(f.Values[0])) < 0.0001)
+	assert.Check(t, math.Abs(math.Abs(imag(f.Values[0]))-1) < 0.0001)
+
+	// Upper triangular with real eigenvalues
+	a = New(&err, []float64{1, 2, 3}, []float64{0, 4, 5}, []float64{0, 0, 6})
+	assert.NilError(t, err)
+	f = a.Eigen(&err)
+	assert.NilError(t, err)
+	checkEigen(t, a, f)
+
+	// 4x4 with a complex pair
+	a = New(&err,
+		[]float64{4, -5, 0, 3},
+		[]float64{0, 4, -3, -5},
+		[]float64{5, -3, 4, 0},
+		[]float64{3, 0, 5, 4},
+	)
+	assert.NilError(t, err)
+	f = a.Eigen(&err)
+	assert.NilError(t, err)
+	checkEigen(t, a, f)
+
+	// Companion matrix of x^3 - 6x^2 + 11x - 6
+	a = New(&err, []float64{6, -11, 6}, []float64{1, 0, 0}, []float64{0, 1, 0})
+	assert.NilError(t, err)
+	f = a.Eigen(&err)
+	assert.NilError(t, err)
+	checkEigen(t, a, f)
+	sum := complex(0, 0)
+	for _, v := range f.Values {
+		sum += v
+	}
+	assert.Check(t, cmplx.Abs(sum-6) < 0.0001)
+
+	// Identity
+	a = NewIdentity[float64](&err, Dimension{Width: 3, Height: 3})
+	assert.NilError(t, err)
+	f = a.Eigen(&err)
+	assert.NilError(t, err)
+	checkEigen(t, a, f)
+
+	// Symmetric
+	a = New(&err, []float64{2, -1, 0}, []float64{-1, 2, -1}, []float64{0, -1, 2})
+	assert.NilError(t, err)
+	f = a.Eigen(&err)
+	assert.NilError(t, err)
+	checkEigen(t, a, f)
+
+	// 1x1
+	a = New(&err, []float64{-2})
+	assert.NilError(t, err)
+	f = a.Eigen(&err)
+	assert.NilError(t, err)
+	checkEigen(t, a, f)
+	assert.Equal(t, f.Values[0], complex(-2, 0))
+}
+
+func TestEigenErrors(t *testing.T) {
+	var err error
+
+	a := New(&err, []float64{1, 2, 3}, []float64{4, 5, 6})
+	assert.NilError(t, err)
+	_ = a.Eigen(&err)
+	assert.ErrorContains(t, err, "cannot calculate the eigendecomposition of a non-square matrix")
+	err = nil
+
+	a = New(&err, []float64{1, 2}, []float64{math.Inf(1), 4})
+	assert.NilError(t, err)
+	_ = a.Eigen(&err)
+	assert.ErrorContains(t, err, "cannot calculate the eigendecomposition of a matrix with non-finite values")
+}
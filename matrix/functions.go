@@ -0,0 +1,226 @@
+package matrix
+
+import (
+	"errors"
+	"math"
+)
+
+// Exp calculates the matrix exponential of a square matrix using scaling and
+// squaring with a degree 13 Padé approximant.
+// https://doi.org/10.1137/04061101X
+func (a Matrix[T]) Exp(err *error) Matrix[T] {
+	// Avoid hiding previous errors.
+	if *err != nil {
+		return Matrix[T]{}
+	}
+
+	// Check the matrix is square.
+	if a.Dimensions.Height != a.Dimensions.Width {
+		*err = errors.New("cannot calculate the exponential of a non-square matrix")
+		return Matrix[T]{}
+	}
+	if !a.isFinite() {
+		*err = errors.New("cannot calculate the exponential of a matrix with non-finite values")
+		return Matrix[T]{}
+	}
+
+	x := a.float64Values()
+	n := len(x)
+
+	// Scale A so the approximant is accurate, then square the result back up.
+	const theta13 = 5.371920351148152
+	s := 0
+	if norm := oneNorm(x); norm > theta13 {
+		s = int(math.Ceil(math.Log2(norm / theta13)))
+		x = scale(x, math.Pow(2, -float64(s)))
+	}
+
+	b := []float64{
+		64764752532480000, 32382376266240000, 7771770303897600, 1187353796428800,
+		129060195264000, 10559470521600, 670442572800, 33522128640,
+		1323241920, 40840800, 960960, 16380, 182, 1,
+	}
+	i := identity(n)
+	x2 := multiply(x, x)
+	x4 := multiply(x2, x2)
+	x6 := multiply(x4, x2)
+	u := multiply(x, add(
+		multiply(x6, add(scale(x6, b[13]), scale(x4, b[11]), scale(x2, b[9]))),
+		scale(x6, b[7]), scale(x4, b[5]), scale(x2, b[3]), scale(i, b[1]),
+	))
+	v := add(
+		multiply(x6, add(scale(x6, b[12]), scale(x4, b[10]), scale(x2, b[8]))),
+		scale(x6, b[6]), scale(x4, b[4]), scale(x2, b[2]), scale(i, b[0]),
+	)
+
+	// Solve (V - U) * R = (V + U).
+	f := fromFloat64[float64](add(v, scale(u, -1))).LU(err)
+	if f.singular() {
+		*err = errors.New("cannot calculate the exponential, Padé approximant is singular")
+		return Matrix[T]{}
+	}
+	r := f.solve(add(v, u))
+	for k := 0; k < s; k++ {
+		r = multiply(r, r)
+	}
+	if !fromFloat64[float64](r).isFinite() {
+		*err = errors.New("cannot calculate the exponential, result overflows")
+		return Matrix[T]{}
+	}
+
+	return fromFloat64[T](r)
+}
+
+// Sqrt calculates the principal square root of a square matrix using the
+// Denman-Beavers iteration. The matrix must not have eigenvalues on the closed
+// negative real axis.
+// https://en.wikipedia.org/wiki/Square_root_of_a_matrix#By_Denman%E2%80%93Beavers_iteration
+func (a Matrix[T]) Sqrt(err *error) Matrix[T] {
+	// Avoid hiding previous errors.
+	if *err != nil {
+		return Matrix[T]{}
+	}
+
+	// Check the matrix is square.
+	if a.Dimensions.Height != a.Dimensions.Width {
+		*err = errors.New("cannot calculate the square root of a non-square matrix")
+		return Matrix[T]{}
+	}
+	if !a.isFinite() {
+		*err = errors.New("cannot calculate the square root of a matrix with non-finite values")
+		return Matrix[T]{}
+	}
+
+	y, ok := sqrtm(a.float64Values())
+	if !ok {
+		*err = errors.New("cannot calculate the square root, matrix has no principal square root")
+		return Matrix[T]{}
+	}
+	return fromFloat64[T](y)
+}
+
+// Log calculates the principal logarithm of a square matrix using inverse
+// scaling and squaring. Square roots are taken until the matrix is close to the
+// identity, where the logarithm's series converges quickly. The matrix must not
+// have eigenvalues on the closed negative real axis.
+// https://en.wikipedia.org/wiki/Logarithm_of_a_matrix
+func (a Matrix[T]) Log(err *error) Matrix[T] {
+	// Avoid hiding previous errors.
+	if *err != nil {
+		return Matrix[T]{}
+	}
+
+	// Check the matrix is square.
+	if a.Dimensions.Height != a.Dimensions.Width {
+		*err = errors.New("cannot calculate the logarithm of a non-square matrix")
+		return Matrix[T]{}
+	}
+	if !a.isFinite() {
+		*err = errors.New("cannot calculate the logarithm of a matrix with non-finite values")
+		return Matrix[T]{}
+	}
+
+	x := a.float64Values()
+	n := len(x)
+	i := identity(n)
+
+	// Take square roots until A is close to I.
+	k := 0
+	for ; oneNorm(add(x, scale(i, -1))) > 0.25; k++ {
+		var ok bool
+		x, ok = sqrtm(x)
+		if !ok || k > 60 {
+			*err = errors.New("cannot calculate the logarithm, matrix has no principal logarithm")
+			return Matrix[T]{}
+		}
+	}
+
+	// log(A) = 2 * atanh(Z) = 2 * (Z + Z^3/3 + Z^5/5 + ...), where
+	// Z = inverse(A + I) * (A - I).
+	f := fromFloat64[float64](add(x, i)).LU(err)
+	if f.singular() {
+		*err = errors.New("cannot calculate the logarithm, matrix has no principal logarithm")
+		return Matrix[T]{}
+	}
+	z := f.solve(add(x, scale(i, -1)))
+	z2 := multiply(z, z)
+	term := z
+	sum := z
+	for j := 3; j < 200; j += 2 {
+		term = multiply(term, z2)
+		sum = add(sum, scale(term, 1/float64(j)))
+		if maxAbs(term)/float64(j) <= 1e-17*maxAbs(sum) {
+			break
+		}
+	}
+
+	return fromFloat64[T](scale(sum, math.Pow(2, float64(k+1))))
+}
+
+// sqrtm calculates the principal square root of a using the Denman-Beavers
+// iteration, reporting whether the iteration converged.
+func sqrtm(a [][]float64) ([][]float64, bool) {
+	var err error
+	y := a
+	z := identity(len(a))
+	for k := 0; k < 100; k++ {
+		fy := fromFloat64[float64](y).LU(&err)
+		fz := fromFloat64[float64](z).LU(&err)
+		if fy.singular() || fz.singular() {
+			return nil, false
+		}
+		yi := fy.solve(identity(len(a)))
+		zi := fz.solve(identity(len(a)))
+
+		next := scale(add(y, zi), 0.5)
+		z = scale(add(z, yi), 0.5)
+		if maxAbs(add(next, scale(y, -1))) <= 1e-14*maxAbs(next) {
+			return next, true
+		}
+		y = next
+	}
+	return nil, false
+}
+
+// multiply calculates a * b.
+func multiply(a, b [][]float64) [][]float64 {
+	m := make([][]float64, len(a))
+	for j := range a {
+		m[j] = make([]float64, len(b[0]))
+		for k := range b {
+			if a[j][k] == 0 {
+				continue
+			}
+			for i := range b[k] {
+				m[j][i] += a[j][k] * b[k][i]
+			}
+		}
+	}
+	return m
+}
+
+// add calculates the sum of matrices of equal dimensions.
+func add(values ...[][]float64) [][]float64 {
+	m := make([][]float64, len(values[0]))
+	for j := range m {
+		m[j] = make([]float64, len(values[0][j]))
+		for _, v := range values {
+			for i := range m[j] {
+				m[j][i] += v[j][i]
+			}
+		}
+	}
+	return m
+}
+
+// scale calculates x * a.
+func scale(a [][]float64, x float64) [][]float64 {
+	m := make([][]float64, len(a))
+	for j := range a {
+		m[j] = make([]float64, len(a[j]))
+		for i := range a[j] {
+			m[j][i] = a[j][i] * x
+		}
+	}
+	return m
+}
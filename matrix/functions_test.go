@@ -0,0 +1,177 @@
+package matrix
+
+import (
+	"math"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestExp(t *testing.T) {
+	var err error
+
+	// Zero
+	a := NewZero[float64](&err, Dimension{Width: 2, Height: 2})
+	assert.NilError(t, err)
+	m := a.Exp(&err)
+	assert.NilError(t, err)
+	i := NewIdentity[float64](&err, a.Dimensions)
+	assert.NilError(t, err)
+	assert.Check(t, m.ApproxEqual(i, 1e-12))
+
+	// Diagonal
+	a = New(&err, []float64{1, 0}, []float64{0, -2})
+	assert.NilError(t, err)
+	m = a.Exp(&err)
+	assert.NilError(t, err)
+	r := New(&err, []float64{math.E, 0}, []float64{0, math.Exp(-2)})
+	assert.NilError(t, err)
+	assert.Check(t, m.ApproxEqual(r, 1e-12))
+
+	// Nilpotent
+	a = New(&err, []float64{0, 1}, []float64{0, 0})
+	assert.NilError(t, err)
+	m = a.Exp(&err)
+	assert.NilError(t, err)
+	r = New(&err, []float64{1, 1}, []float64{0, 1})
+	assert.NilError(t, err)
+	assert.Check(t, m.ApproxEqual(r, 1e-12))
+
+	// Rotation
+	theta := 10.0
+	a = New(&err, []float64{0, -theta}, []float64{theta, 0})
+	assert.NilError(t, err)
+	m = a.Exp(&err)
+	assert.NilError(t, err)
+	r = New(&err,
+		[]float64{math.Cos(theta), -math.Sin(theta)},
+		[]float64{math.Sin(theta), math.Cos(theta)},
+	)
+	assert.NilError(t, err)
+	assert.Check(t, m.ApproxEqual(r, 1e-10))
+
+	// A classic example that requires scaling and squaring
+	a = New(&err, []float64{-49, 24}, []float64{-64, 31})
+	assert.NilError(t, err)
+	m = a.Exp(&err)
+	assert.NilError(t, err)
+	r = New(&err,
+		[]float64{-2*math.Exp(-1) + 3*math.Exp(-17), 1.5*math.Exp(-1) - 1.5*math.Exp(-17)},
+		[]float64{-4*math.Exp(-1) + 4*math.Exp(-17), 3*math.Exp(-1) - 2*math.Exp(-17)},
+	)
+	assert.NilError(t, err)
+	assert.Check(t, m.ApproxEqual(r, 1e-10))
+
+	b := New(&err, []float64{1, 2, 3})
+	assert.NilError(t, err)
+	_ = b.Exp(&err)
+	assert.ErrorContains(t, err, "cannot calculate the exponential of a non-square matrix")
+	err = nil
+
+	b = New(&err, []float64{1, math.NaN()}, []float64{0, 1})
+	assert.NilError(t, err)
+	_ = b.Exp(&err)
+	assert.ErrorContains(t, err, "cannot calculate the exponential of a matrix with non-finite values")
+	err = nil
+
+	b = New(&err, []float64{1000, 0}, []float64{0, 1})
+	assert.NilError(t, err)
+	_ = b.Exp(&err)
+	assert.ErrorContains(t, err, "cannot calculate the exponential, result overflows")
+}
+
+func TestSqrt(t *testing.T) {
+	var err error
+
+	// Diagonal
+	a := New(&err, []float64{4, 0}, []float64{0, 9})
+	assert.NilError(t, err)
+	m := a.Sqrt(&err)
+	assert.NilError(t, err)
+	r := New(&err, []float64{2, 0}, []float64{0, 3})
+	assert.NilError(t, err)
+	assert.Check(t, m.ApproxEqual(r, 1e-10))
+
+	// Non-symmetric - validate sqrt(A)^2 = A
+	a = New(&err, []float64{33, 24}, []float64{48, 57})
+	assert.NilError(t, err)
+	m = a.Sqrt(&err)
+	assert.NilError(t, err)
+	r = New(&err, []float64{5, 2}, []float64{4, 7})
+	assert.NilError(t, err)
+	assert.Check(t, m.ApproxEqual(r, 1e-10))
+
+	// Complex eigenvalues with a real principal square root
+	a = New(&err, []float64{1, -2}, []float64{2, 1})
+	assert.NilError(t, err)
+	m = a.Sqrt(&err)
+	assert.NilError(t, err)
+	s := m.Multiply(&err, m)
+	assert.NilError(t, err)
+	assert.Check(t, s.ApproxEqual(a, 1e-10))
+
+	// Negative eigenvalue
+	a = New(&err, []float64{-1, 0}, []float64{0, 1})
+	assert.NilError(t, err)
+	_ = a.Sqrt(&err)
+	assert.ErrorContains(t, err, "cannot calculate the square root, matrix has no principal square root")
+	err = nil
+
+	b := New(&err, []float64{1, 2, 3})
+	assert.NilError(t, err)
+	_ = b.Sqrt(&err)
+	assert.ErrorContains(t, err, "cannot calculate the square root of a non-square matrix")
+	err = nil
+
+	b = New(&err, []float64{1, math.NaN()}, []float64{0, 1})
+	assert.NilError(t, err)
+	_ = b.Sqrt(&err)
+	assert.ErrorContains(t, err, "cannot calculate the square root of a matrix with non-finite values")
+}
+
+func TestLog(t *testing.T) {
+	var err error
+
+	// Identity
+	a := NewIdentity[float64](&err, Dimension{Width: 3, Height: 3})
+	assert.NilError(t, err)
+	m := a.Log(&err)
+	assert.NilError(t, err)
+	z := NewZero[float64](&err, a.Dimensions)
+	assert.NilError(t, err)
+	assert.Check(t, m.ApproxEqual(z, 1e-12))
+
+	// Diagonal
+	a = New(&err, []float64{math.E, 0}, []float64{0, 100})
+	assert.NilError(t, err)
+	m = a.Log(&err)
+	assert.NilError(t, err)
+	r := New(&err, []float64{1, 0}, []float64{0, math.Log(100)})
+	assert.NilError(t, err)
+	assert.Check(t, m.ApproxEqual(r, 1e-10))
+
+	// Log is the inverse of Exp
+	r = New(&err, []float64{0.5, -1, 0.2}, []float64{1, 0.1, 0}, []float64{0.3, 0.4, -0.7})
+	assert.NilError(t, err)
+	m = r.Exp(&err).Log(&err)
+	assert.NilError(t, err)
+	assert.Check(t, m.ApproxEqual(r, 1e-9))
+
+	// Negative eigenvalue
+	a = New(&err, []float64{-1, 0}, []float64{0, 1})
+	assert.NilError(t, err)
+	_ = a.Log(&err)
+	assert.ErrorContains(t, err, "cannot calculate the logarithm, matrix has no principal logarithm")
+	err = nil
+
+	b := New(&err, []float64{1, 2, 3})
+	assert.NilError(t, err)
+	_ = b.Log(&err)
+	assert.ErrorContains(t, err, "cannot calculate the logarithm of a non-square matrix")
+	err = nil
+
+	b = New(&err, []float64{1, math.NaN()}, []float64{0, 1})
+	assert.NilError(t, err)
+	_ = b.Log(&err)
+	assert.ErrorContains(t, err, "cannot calculate the logarithm of a matrix with non-finite values")
+}
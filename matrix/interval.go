@@ -0,0 +1,596 @@
+package matrix
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Interval is a closed interval of real numbers [Lower, Upper].
+// Operations round outwards when a result is not exactly representable, so
+// the result of an operation always contains every result of the operation
+// applied to values in the operands.
+// https://en.wikipedia.org/wiki/Interval_arithmetic
+type Interval struct {
+	Lower float64
+	Upper float64
+}
+
+// NewInterval instantiates an Interval with the passed bounds.
+func NewInterval(err *error, lower, upper float64) Interval {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return Interval{}
+	}
+
+	if math.IsNaN(lower) || math.IsNaN(upper) {
+		*err = errors.New("cannot create an Interval with a NaN bound")
+		return Interval{}
+	}
+	if lower > upper {
+		*err = errors.New("cannot create an Interval with a lower bound greater than the upper bound")
+		return Interval{}
+	}
+
+	return Interval{Lower: lower, Upper: upper}
+}
+
+// Point returns the degenerate interval [x, x].
+func Point(x float64) Interval {
+	return Interval{Lower: x, Upper: x}
+}
+
+// Add adds two intervals.
+func (a Interval) Add(b Interval) Interval {
+	return Interval{
+		Lower: addDown(a.Lower, b.Lower),
+		Upper: addUp(a.Upper, b.Upper),
+	}
+}
+
+// Subtract subtracts an interval from another one.
+func (a Interval) Subtract(b Interval) Interval {
+	return Interval{
+		Lower: addDown(a.Lower, -b.Upper),
+		Upper: addUp(a.Upper, -b.Lower),
+	}
+}
+
+// Multiply multiplies two intervals.
+func (a Interval) Multiply(b Interval) Interval {
+	lower := math.Min(
+		math.Min(mulDown(a.Lower, b.Lower), mulDown(a.Lower, b.Upper)),
+		math.Min(mulDown(a.Upper, b.Lower), mulDown(a.Upper, b.Upper)),
+	)
+	upper := math.Max(
+		math.Max(mulUp(a.Lower, b.Lower), mulUp(a.Lower, b.Upper)),
+		math.Max(mulUp(a.Upper, b.Lower), mulUp(a.Upper, b.Upper)),
+	)
+	return Interval{Lower: lower, Upper: upper}
+}
+
+// Divide divides an interval by another one, which must not contain zero.
+func (a Interval) Divide(err *error, b Interval) Interval {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return Interval{}
+	}
+
+	if b.Contains(0) {
+		*err = errors.New("cannot divide by an interval containing zero")
+		return Interval{}
+	}
+
+	lower := math.Min(
+		math.Min(divDown(a.Lower, b.Lower), divDown(a.Lower, b.Upper)),
+		math.Min(divDown(a.Upper, b.Lower), divDown(a.Upper, b.Upper)),
+	)
+	upper := math.Max(
+		math.Max(divUp(a.Lower, b.Lower), divUp(a.Lower, b.Upper)),
+		math.Max(divUp(a.Upper, b.Lower), divUp(a.Upper, b.Upper)),
+	)
+	return Interval{Lower: lower, Upper: upper}
+}
+
+// Contains reports whether x is in the interval.
+func (a Interval) Contains(x float64) bool {
+	return a.Lower <= x && x <= a.Upper
+}
+
+// Midpoint returns the midpoint of the interval.
+func (a Interval) Midpoint() float64 {
+	return a.Lower/2 + a.Upper/2
+}
+
+// Width returns the width of the interval, rounded up.
+func (a Interval) Width() float64 {
+	return addUp(a.Upper, -a.Lower)
+}
+
+// Magnitude returns the largest absolute value in the interval.
+func (a Interval) Magnitude() float64 {
+	return math.Max(math.Abs(a.Lower), math.Abs(a.Upper))
+}
+
+// Mignitude returns the smallest absolute value in the interval.
+func (a Interval) Mignitude() float64 {
+	if a.Contains(0) {
+		return 0
+	}
+	return math.Min(math.Abs(a.Lower), math.Abs(a.Upper))
+}
+
+func (a Interval) String() string {
+	return fmt.Sprintf("[%v, %v]", a.Lower, a.Upper)
+}
+
+// IntervalMatrix is a matrix of intervals.
+// The result of each operation encloses the results of the operation applied to
+// every choice of matrices with values in the intervals of the operands.
+type IntervalMatrix struct {
+	Dimensions Dimension
+	Values     [][]Interval
+}
+
+// NewIntervalMatrix instantiates an IntervalMatrix with the passed values.
+func NewIntervalMatrix(err *error, values ...[]Interval) IntervalMatrix {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return IntervalMatrix{}
+	}
+
+	height := len(values)
+	if height < 1 {
+		*err = errors.New("cannot create a Matrix with a dimension that is less than 1")
+		return IntervalMatrix{}
+	}
+
+	width := len(values[0])
+	if width < 1 {
+		*err = errors.New("cannot create a Matrix with a dimension that is less than 1")
+		return IntervalMatrix{}
+	}
+
+	for _, row := range values {
+		if len(row) != width {
+			*err = errors.New("cannot create a Matrix with different row lengths")
+			return IntervalMatrix{}
+		}
+	}
+
+	return IntervalMatrix{
+		Dimensions: Dimension{
+			Width:  width,
+			Height: height,
+		},
+		Values: values,
+	}
+}
+
+// Interval converts a Matrix to an IntervalMatrix of point intervals.
+// Integer values that cannot be represented exactly as a float64 are enclosed
+// by the neighbouring float64 values.
+func (a Matrix[T]) Interval() IntervalMatrix {
+	values := make([][]Interval, a.Dimensions.Height)
+	for j := range values {
+		values[j] = make([]Interval, a.Dimensions.Width)
+		for i := range values[j] {
+			values[j][i] = pointOf(a.Values[j][i])
+		}
+	}
+
+	return IntervalMatrix{
+		Dimensions: a.Dimensions,
+		Values:     values,
+	}
+}
+
+// MultiplyScalar multiplies the Matrix by a scalar interval.
+func (a IntervalMatrix) MultiplyScalar(err *error, x Interval) IntervalMatrix {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return IntervalMatrix{}
+	}
+
+	m := a.Clone()
+	for j := 0; j < a.Dimensions.Height; j++ {
+		for i := 0; i < a.Dimensions.Width; i++ {
+			m.Values[j][i] = m.Values[j][i].Multiply(x)
+		}
+	}
+	return m
+}
+
+// Multiply multiplies the matrix by another matrix.
+// The height of matix B must match the width of matrix A.
+func (a IntervalMatrix) Multiply(err *error, b IntervalMatrix) IntervalMatrix {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return IntervalMatrix{}
+	}
+
+	// Check matrices can be multiplied.
+	if a.Dimensions.Width != b.Dimensions.Height {
+		*err = errors.New("cannot multiply matrices due to incompatible dimensions")
+		return IntervalMatrix{}
+	}
+
+	width := b.Dimensions.Width
+	height := a.Dimensions.Height
+	m := make([][]Interval, height)
+	for j := 0; j < height; j++ {
+		m[j] = make([]Interval, width)
+		for x := 0; x < width; x++ {
+			sum := Interval{}
+			for i := 0; i < a.Dimensions.Width; i++ {
+				sum = sum.Add(a.Values[j][i].Multiply(b.Values[i][x]))
+			}
+			m[j][x] = sum
+		}
+	}
+
+	return IntervalMatrix{
+		Dimensions: Dimension{
+			Width:  width,
+			Height: height,
+		},
+		Values: m,
+	}
+}
+
+// Add a matrix to another one.
+// The dimensions of the matrices must match.
+func (a IntervalMatrix) Add(err *error, b IntervalMatrix) IntervalMatrix {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return IntervalMatrix{}
+	}
+
+	// Check matrices can be added.
+	if a.Dimensions != b.Dimensions {
+		*err = errors.New("cannot add matrices due to incompatible dimensions")
+		return IntervalMatrix{}
+	}
+
+	m := a.Clone()
+	for j := 0; j < a.Dimensions.Height; j++ {
+		for i := 0; i < a.Dimensions.Width; i++ {
+			m.Values[j][i] = m.Values[j][i].Add(b.Values[j][i])
+		}
+	}
+	return m
+}
+
+// Subtract a matrix from another one.
+// The dimensions of the matrices must match.
+func (a IntervalMatrix) Subtract(err *error, b IntervalMatrix) IntervalMatrix {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return IntervalMatrix{}
+	}
+
+	// Check matrices can be subtracted.
+	if a.Dimensions != b.Dimensions {
+		*err = errors.New("cannot subtract matrices due to incompatible dimensions")
+		return IntervalMatrix{}
+	}
+
+	m := a.Clone()
+	for j := 0; j < a.Dimensions.Height; j++ {
+		for i := 0; i < a.Dimensions.Width; i++ {
+			m.Values[j][i] = m.Values[j][i].Subtract(b.Values[j][i])
+		}
+	}
+	return m
+}
+
+// Solve encloses the solutions of A * X = B for every choice of A and B with
+// values in the intervals. B may have any number of columns.
+// The system is preconditioned with the inverse of the midpoint of A and solved
+// with interval Gaussian elimination. An error is reported if a pivot interval
+// contains zero or is unbounded, which happens when the intervals of A contain
+// a singular matrix or are too wide for the enclosure to be bounded.
+// https://en.wikipedia.org/wiki/Interval_arithmetic#Linear_interval_systems
+func (a IntervalMatrix) Solve(err *error, b IntervalMatrix) IntervalMatrix {
+	// Avoid hiding previous errors.
+	if *err != nil {
+		return IntervalMatrix{}
+	}
+
+	// Check the system can be solved.
+	if a.Dimensions.Height != a.Dimensions.Width {
+		*err = errors.New("cannot solve a non-square system")
+		return IntervalMatrix{}
+	}
+	if a.Dimensions.Height != b.Dimensions.Height {
+		*err = errors.New("cannot solve due to incompatible dimensions")
+		return IntervalMatrix{}
+	}
+
+	// Preconditioning with any point matrix keeps the enclosure valid, so fall
+	// back to the original system if the midpoint is unbounded or cannot be
+	// inverted.
+	var inverseErr error
+	mid := a.Midpoint()
+	c := mid.Inverse(&inverseErr)
+	if mid.isFinite() && inverseErr == nil && c.isFinite() {
+		a = c.Interval().Multiply(err, a)
+		b = c.Interval().Multiply(err, b)
+	} else {
+		a = a.Clone()
+		b = b.Clone()
+	}
+
+	n := a.Dimensions.Width
+	width := b.Dimensions.Width
+	m := a.Values
+	x := b.Values
+	for k := 0; k < n; k++ {
+		// Pivot on the interval furthest from zero.
+		p := k
+		for j := k + 1; j < n; j++ {
+			if m[j][k].Mignitude() > m[p][k].Mignitude() {
+				p = j
+			}
+		}
+		if m[p][k].Contains(0) {
+			*err = errors.New("cannot solve, pivot interval contains zero")
+			return IntervalMatrix{}
+		}
+		if math.IsNaN(m[p][k].Lower) || math.IsNaN(m[p][k].Upper) ||
+			math.IsInf(m[p][k].Lower, 0) || math.IsInf(m[p][k].Upper, 0) {
+			*err = errors.New("cannot solve, pivot interval is unbounded")
+			return IntervalMatrix{}
+		}
+		m[k], m[p] = m[p], m[k]
+		x[k], x[p] = x[p], x[k]
+
+		for j := k + 1; j < n; j++ {
+			f := m[j][k].Divide(err, m[k][k])
+			for i := k + 1; i < n; i++ {
+				m[j][i] = m[j][i].Subtract(f.Multiply(m[k][i]))
+			}
+			for i := 0; i < width; i++ {
+				x[j][i] = x[j][i].Subtract(f.Multiply(x[k][i]))
+			}
+		}
+	}
+
+	// Back substitution
+	for j := n - 1; j >= 0; j-- {
+		for i := 0; i < width; i++ {
+			sum := x[j][i]
+			for k := j + 1; k < n; k++ {
+				sum = sum.Subtract(m[j][k].Multiply(x[k][i]))
+			}
+			x[j][i] = sum.Divide(err, m[j][j])
+		}
+	}
+	if *err != nil {
+		return IntervalMatrix{}
+	}
+
+	return IntervalMatrix{
+		Dimensions: b.Dimensions,
+		Values:     x,
+	}
+}
+
+// Midpoint returns the matrix of the midpoints of the intervals.
+func (a IntervalMatrix) Midpoint() Matrix[float64] {
+	values := make([][]float64, a.Dimensions.Height)
+	for j := range values {
+		values[j] = make([]float64, a.Dimensions.Width)
+		for i := range values[j] {
+			values[j][i] = a.Values[j][i].Midpoint()
+		}
+	}
+
+	return Matrix[float64]{
+		Dimensions: a.Dimensions,
+		Values:     values,
+	}
+}
+
+// Contains reports whether every value of b is in the corresponding interval.
+func (a IntervalMatrix) Contains(b Matrix[float64]) bool {
+	if a.Dimensions != b.Dimensions {
+		return false
+	}
+	for j := 0; j < a.Dimensions.Height; j++ {
+		for i := 0; i < a.Dimensions.Width; i++ {
+			if !a.Values[j][i].Contains(b.Values[j][i]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Transpose calculates the transpose of a Matrix.
+func (a IntervalMatrix) Transpose(err *error) IntervalMatrix {
+	values := make([][]Interval, a.Dimensions.Width)
+	for j := 0; j < a.Dimensions.Width; j++ {
+		values[j] = make([]Interval, a.Dimensions.Height)
+		for i := 0; i < a.Dimensions.Height; i++ {
+			values[j][i] = a.Values[i][j]
+		}
+	}
+
+	return IntervalMatrix{
+		Dimensions: Dimension{
+			Width:  a.Dimensions.Height,
+			Height: a.Dimensions.Width,
+		},
+		Values: values,
+	}
+}
+
+func (a IntervalMatrix) Clone() IntervalMatrix {
+	values := make([][]Interval, a.Dimensions.Height)
+	for j := 0; j < a.Dimensions.Height; j++ {
+		values[j] = make([]Interval, a.Dimensions.Width)
+		copy(values[j], a.Values[j])
+	}
+
+	return IntervalMatrix{
+		Dimensions: a.Dimensions,
+		Values:     values,
+	}
+}
+
+func (a IntervalMatrix) Equal(b IntervalMatrix) bool {
+	if a.Dimensions != b.Dimensions {
+		return false
+	}
+	for j := 0; j < a.Dimensions.Height; j++ {
+		for i := 0; i < a.Dimensions.Width; i++ {
+			if a.Values[j][i] != b.Values[j][i] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (a IntervalMatrix) String() string {
+	return fmt.Sprint(a.Values)
+}
+
+// pointOf returns the smallest interval of float64 values containing v.
+func pointOf[T constraints.Integer | constraints.Float](v T) Interval {
+	f := float64(v)
+	if !isInteger[T]() || math.Abs(f) < 1<<53 {
+		return Point(f)
+	}
+	// Large integers may have been rounded, and converting f back to T may
+	// overflow, so compare exactly.
+	x, _ := big.NewFloat(f).Int(nil)
+	switch bigInt(v).Cmp(x) {
+	case -1:
+		return Interval{Lower: math.Nextafter(f, math.Inf(-1)), Upper: f}
+	case 1:
+		return Interval{Lower: f, Upper: math.Nextafter(f, math.Inf(1))}
+	}
+	return Point(f)
+}
+
+// addDown returns a lower bound for a + b.
+func addDown(a, b float64) float64 {
+	s, e := twoSum(a, b)
+	if e < 0 || math.IsNaN(e) {
+		return math.Nextafter(s, math.Inf(-1))
+	}
+	return s
+}
+
+// addUp returns an upper bound for a + b.
+func addUp(a, b float64) float64 {
+	s, e := twoSum(a, b)
+	if e > 0 || math.IsNaN(e) {
+		return math.Nextafter(s, math.Inf(1))
+	}
+	return s
+}
+
+// mulDown returns a lower bound for a * b, taking 0 * Inf to be 0.
+func mulDown(a, b float64) float64 {
+	p, e := twoProduct(a, b)
+	if e < 0 || math.IsNaN(e) {
+		return math.Nextafter(p, math.Inf(-1))
+	}
+	return p
+}
+
+// mulUp returns an upper bound for a * b, taking 0 * Inf to be 0.
+func mulUp(a, b float64) float64 {
+	p, e := twoProduct(a, b)
+	if e > 0 || math.IsNaN(e) {
+		return math.Nextafter(p, math.Inf(1))
+	}
+	return p
+}
+
+// divDown returns a lower bound for a / b.
+func divDown(a, b float64) float64 {
+	q, e := twoQuotient(a, b)
+	if e < 0 || math.IsNaN(e) {
+		return math.Nextafter(q, math.Inf(-1))
+	}
+	return q
+}
+
+// divUp returns an upper bound for a / b.
+func divUp(a, b float64) float64 {
+	q, e := twoQuotient(a, b)
+	if e > 0 || math.IsNaN(e) {
+		return math.Nextafter(q, math.Inf(1))
+	}
+	return q
+}
+
+// twoSum returns a + b rounded to nearest, and a value with the sign of the
+// rounding error, the exact sum minus the rounded one.
+// https://en.wikipedia.org/wiki/2Sum
+func twoSum(a, b float64) (float64, float64) {
+	s := a + b
+	if math.IsInf(s, 0) {
+		// Overflow from finite operands always rounds away from zero.
+		if !math.IsInf(a, 0) && !math.IsInf(b, 0) {
+			return s, -s
+		}
+		return s, 0
+	}
+	t := s - a
+	return s, (a - (s - t)) + (b - t)
+}
+
+// twoProduct returns a * b rounded to nearest, and a value with the sign of the
+// rounding error.
+func twoProduct(a, b float64) (float64, float64) {
+	if a == 0 || b == 0 {
+		return 0, 0
+	}
+	p := a * b
+	if math.IsInf(p, 0) {
+		if !math.IsInf(a, 0) && !math.IsInf(b, 0) {
+			return p, -p
+		}
+		return p, 0
+	}
+	if math.Abs(p) < exactLimit {
+		// The error of a small product may not be representable, so report
+		// NaN to widen in both directions.
+		return p, math.NaN()
+	}
+	return p, math.FMA(a, b, -p)
+}
+
+// twoQuotient returns a / b rounded to nearest, and a value with the sign of the
+// rounding error.
+func twoQuotient(a, b float64) (float64, float64) {
+	q := a / b
+	if math.IsInf(a, 0) || math.IsInf(b, 0) || a == 0 {
+		return q, 0
+	}
+	if math.IsInf(q, 0) {
+		return q, -q
+	}
+	if math.Abs(a) < exactLimit || math.Abs(b) < exactLimit || math.Abs(q) < exactLimit {
+		// The remainder of small operands may underflow, so report NaN to
+		// widen in both directions.
+		return q, math.NaN()
+	}
+	// The remainder a - q*b is exact, and the error (a - q*b) / b has the
+	// sign of the remainder times the sign of b.
+	r := math.FMA(-q, b, a)
+	return q, r * math.Copysign(1, b)
+}
+
+// exactLimit is the magnitude below which the error of a product or quotient
+// may not be exactly representable, 2^53 times the smallest positive normal
+// float64.
+const exactLimit = 0x1p-1022 * 0x1p53
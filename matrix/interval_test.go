@@ -0,0 +1,241 @@
+package matrix
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestNewInterval(t *testing.T) {
+	var err error
+
+	a := NewInterval(&err, -1, 2)
+	assert.NilError(t, err)
+	assert.Equal(t, a.String(), "[-1, 2]")
+	assert.Equal(t, a.Midpoint(), 0.5)
+	assert.Equal(t, a.Width(), 3.0)
+	assert.Equal(t, a.Magnitude(), 2.0)
+	assert.Equal(t, a.Mignitude(), 0.0)
+	assert.Equal(t, Interval{Lower: -3, Upper: -2}.Mignitude(), 2.0)
+
+	_ = NewInterval(&err, 2, 1)
+	assert.ErrorContains(t, err, "cannot create an Interval with a lower bound greater than the upper bound")
+	err = nil
+
+	_ = NewInterval(&err, math.NaN(), 1)
+	assert.ErrorContains(t, err, "cannot create an Interval with a NaN bound")
+}
+
+func TestIntervalArithmetic(t *testing.T) {
+	var err error
+
+	a := Interval{Lower: 1, Upper: 2}
+	b := Interval{Lower: -3, Upper: 4}
+
+	// Exact results are not widened.
+	assert.Equal(t, a.Add(b), Interval{Lower: -2, Upper: 6})
+	assert.Equal(t, a.Subtract(b), Interval{Lower: -3, Upper: 5})
+	assert.Equal(t, a.Multiply(b), Interval{Lower: -6, Upper: 8})
+	assert.Equal(t, b.Divide(&err, Interval{Lower: 2, Upper: 4}), Interval{Lower: -1.5, Upper: 2})
+	assert.NilError(t, err)
+
+	// Inexact results are rounded outwards.
+	x, y := 0.1, 0.2
+	c := Point(x).Add(Point(y))
+	assert.Equal(t, c.Upper, math.Nextafter(c.Lower, 1))
+	assert.Check(t, c.Contains(x+y))
+
+	d := Point(1).Divide(&err, Point(3))
+	assert.NilError(t, err)
+	assert.Equal(t, d.Upper, math.Nextafter(d.Lower, 1))
+	assert.Check(t, d.Contains(1.0/3))
+
+	e := Point(math.MaxFloat64).Add(Point(math.MaxFloat64))
+	assert.Equal(t, e, Interval{Lower: math.MaxFloat64, Upper: math.Inf(1)})
+
+	f := Point(0x1p-600).Multiply(Point(-0x1p-600))
+	assert.Check(t, f.Lower < 0 && f.Upper >= 0)
+
+	// Products and quotients near the bottom of the float64 range are still
+	// enclosed.
+	for e := -1040; e <= -950; e += 3 {
+		x := 0x1.5555555555555p0 * math.Ldexp(1, e/2)
+		y := -0x1.3333333333333p0 * math.Ldexp(1, e-e/2)
+		p := Point(x).Multiply(Point(y))
+		exact := new(big.Float).SetPrec(200).Mul(big.NewFloat(x), big.NewFloat(y))
+		assert.Check(t, big.NewFloat(p.Lower).Cmp(exact) <= 0 && exact.Cmp(big.NewFloat(p.Upper)) <= 0, "%v * %v", x, y)
+
+		z := 0x1.5555555555555p0 * math.Ldexp(1, e)
+		q := Point(z).Divide(&err, Point(3))
+		assert.NilError(t, err)
+		// Compare against z, since the exact quotient is not representable.
+		lower := new(big.Float).SetPrec(200).Mul(big.NewFloat(q.Lower), big.NewFloat(3))
+		upper := new(big.Float).SetPrec(200).Mul(big.NewFloat(q.Upper), big.NewFloat(3))
+		assert.Check(t, lower.Cmp(big.NewFloat(z)) <= 0 && big.NewFloat(z).Cmp(upper) <= 0, "%v / 3", z)
+
+		// Subnormal divisor
+		w := 0x1p-1074 * float64(e+1100)
+		q = Point(z).Divide(&err, Point(w))
+		assert.NilError(t, err)
+		lower = new(big.Float).SetPrec(200).Mul(big.NewFloat(q.Lower), big.NewFloat(w))
+		upper = new(big.Float).SetPrec(200).Mul(big.NewFloat(q.Upper), big.NewFloat(w))
+		assert.Check(t, lower.Cmp(big.NewFloat(z)) <= 0 && big.NewFloat(z).Cmp(upper) <= 0, "%v / %v", z, w)
+	}
+
+	h := Point(0x1p-1074).Divide(&err, Point(3*0x1p-1074))
+	assert.NilError(t, err)
+	assert.Check(t, h.Lower < 1.0/3 && h.Upper > 1.0/3)
+
+	g := Interval{Lower: 0, Upper: math.Inf(1)}.Multiply(Point(0))
+	assert.Equal(t, g, Interval{})
+
+	_ = a.Divide(&err, b)
+	assert.ErrorContains(t, err, "cannot divide by an interval containing zero")
+}
+
+func TestIntervalMatrix(t *testing.T) {
+	var err error
+
+	a := NewIntervalMatrix(&err,
+		[]Interval{{Lower: 1, Upper: 2}, Point(0)},
+		[]Interval{Point(-1), {Lower: 3, Upper: 4}},
+	)
+	assert.NilError(t, err)
+	b := New(&err, []float64{1, 2}, []float64{3, 4}).Interval()
+	assert.NilError(t, err)
+
+	m := a.Add(&err, b)
+	assert.NilError(t, err)
+	assert.Equal(t, m.String(), "[[[2, 3] [2, 2]] [[2, 2] [7, 8]]]")
+
+	m = a.Subtract(&err, b)
+	assert.NilError(t, err)
+	assert.Equal(t, m.String(), "[[[0, 1] [-2, -2]] [[-4, -4] [-1, 0]]]")
+
+	m = a.Multiply(&err, b)
+	assert.NilError(t, err)
+	assert.Equal(t, m.String(), "[[[1, 2] [2, 4]] [[8, 11] [10, 14]]]")
+
+	m = a.MultiplyScalar(&err, Interval{Lower: -1, Upper: 1})
+	assert.NilError(t, err)
+	assert.Equal(t, m.String(), "[[[-2, 2] [0, 0]] [[-1, 1] [-4, 4]]]")
+
+	m = a.Transpose(&err)
+	assert.NilError(t, err)
+	assert.Equal(t, m.Values[0][1], Point(-1))
+
+	assert.Check(t, a.Midpoint().Equal(New(&err, []float64{1.5, 0}, []float64{-1, 3.5})))
+	assert.Check(t, a.Contains(New(&err, []float64{1.2, 0}, []float64{-1, 3})))
+	assert.Check(t, !a.Contains(New(&err, []float64{1.2, 0}, []float64{-1, 5})))
+
+	c := New(&err, []float64{1, 2, 3}).Interval()
+	assert.NilError(t, err)
+	_ = c.Multiply(&err, a)
+	assert.ErrorContains(t, err, "cannot multiply matrices due to incompatible dimensions")
+	err = nil
+
+	_ = c.Add(&err, a)
+	assert.ErrorContains(t, err, "cannot add matrices due to incompatible dimensions")
+	err = nil
+
+	_ = NewIntervalMatrix(&err, []Interval{Point(1)}, []Interval{})
+	assert.ErrorContains(t, err, "cannot create a Matrix with different row lengths")
+}
+
+func TestIntervalConversion(t *testing.T) {
+	var err error
+
+	a := New(&err, []int64{math.MaxInt64, 1<<53 + 1, -(1<<53 + 1), 7})
+	assert.NilError(t, err)
+	m := a.Interval()
+	assert.Equal(t, m.Values[0][0], Interval{Lower: math.Nextafter(0x1p63, 0), Upper: 0x1p63})
+	assert.Equal(t, m.Values[0][1], Interval{Lower: 0x1p53, Upper: 0x1p53 + 2})
+	assert.Equal(t, m.Values[0][2], Interval{Lower: -0x1p53 - 2, Upper: -0x1p53})
+	assert.Equal(t, m.Values[0][3], Point(7))
+}
+
+func TestIntervalSolve(t *testing.T) {
+	var err error
+
+	// Every system with coefficients in the intervals has its solution enclosed.
+	a := NewIntervalMatrix(&err,
+		[]Interval{{Lower: 3.9, Upper: 4.1}, {Lower: 0.9, Upper: 1.1}},
+		[]Interval{{Lower: 0.9, Upper: 1.1}, {Lower: 2.9, Upper: 3.1}},
+	)
+	assert.NilError(t, err)
+	b := NewIntervalMatrix(&err,
+		[]Interval{{Lower: 0.99, Upper: 1.01}},
+		[]Interval{{Lower: 1.99, Upper: 2.01}},
+	)
+	assert.NilError(t, err)
+	x := a.Solve(&err, b)
+	assert.NilError(t, err)
+
+	for _, a00 := range []float64{3.9, 4.1} {
+		for _, a01 := range []float64{0.9, 1.1} {
+			for _, a10 := range []float64{0.9, 1.1} {
+				for _, a11 := range []float64{2.9, 3.1} {
+					for _, b0 := range []float64{0.99, 1.01} {
+						for _, b1 := range []float64{1.99, 2.01} {
+							p := New(&err, []float64{a00, a01}, []float64{a10, a11})
+							q := New(&err, []float64{b0}, []float64{b1})
+							s := p.Solve(&err, q)
+							assert.NilError(t, err)
+							assert.Check(t, x.Contains(s), "%v not in %v", s, x)
+						}
+					}
+				}
+			}
+		}
+	}
+
+	// A point system gives a tight enclosure of the exact solution.
+	h := NewZero[float64](&err, Dimension{Width: 6, Height: 6})
+	ones := NewZero[float64](&err, Dimension{Width: 1, Height: 6})
+	assert.NilError(t, err)
+	for j := range h.Values {
+		ones.Values[j][0] = 1
+		for i := range h.Values[j] {
+			h.Values[j][i] = 1 / float64(i+j+1)
+		}
+	}
+	r := h.Interval().Multiply(&err, ones.Interval())
+	y := h.Interval().Solve(&err, r)
+	assert.NilError(t, err)
+	assert.Check(t, y.Contains(ones))
+	for _, row := range y.Values {
+		assert.Check(t, row[0].Width() < 1e-6)
+	}
+
+	s := NewIntervalMatrix(&err,
+		[]Interval{{Lower: 1, Upper: 2}, {Lower: 1, Upper: 2}},
+		[]Interval{{Lower: 1, Upper: 2}, {Lower: 1, Upper: 2}},
+	)
+	assert.NilError(t, err)
+	_ = s.Solve(&err, b)
+	assert.ErrorContains(t, err, "cannot solve, pivot interval contains zero")
+	err = nil
+
+	_ = a.Solve(&err, New(&err, []float64{1}).Interval())
+	assert.ErrorContains(t, err, "cannot solve due to incompatible dimensions")
+	err = nil
+
+	u := NewIntervalMatrix(&err,
+		[]Interval{{Lower: math.Inf(-1), Upper: math.Inf(1)}, Point(0)},
+		[]Interval{Point(0), Point(1)},
+	)
+	assert.NilError(t, err)
+	_ = u.Solve(&err, b)
+	assert.ErrorContains(t, err, "cannot solve, pivot interval contains zero")
+	err = nil
+
+	u = NewIntervalMatrix(&err,
+		[]Interval{{Lower: 1, Upper: math.Inf(1)}, Point(0)},
+		[]Interval{Point(0), Point(1)},
+	)
+	assert.NilError(t, err)
+	_ = u.Solve(&err, b)
+	assert.ErrorContains(t, err, "cannot solve, pivot interval is unbounded")
+}
@@ -0,0 +1,165 @@
+package matrix
+
+import (
+	"errors"
+	"math"
+
+	"golang.org/x/exp/constraints"
+)
+
+// LeastSquares is the least-squares solution of A * X = B along with
+// diagnostics describing its quality.
+type LeastSquares[T constraints.Integer | constraints.Float] struct {
+	// X minimizes the 2-norm of A * X - B for each column of B. If A is rank
+	// deficient, X is the solution with the smallest norm.
+	X Matrix[T]
+	// Residuals holds the 2-norm of each column of A * X - B.
+	Residuals []float64
+	// Rank is the numerical rank of A.
+	Rank int
+	// Condition is the 2-norm condition number of A, the ratio of its largest
+	// to smallest singular value. It is infinite if A is rank deficient.
+	Condition float64
+}
+
+// LeastSquares solves the overdetermined or underdetermined system A * X = B in
+// the least-squares sense. A full-rank A with at least as many rows as columns
+// is solved with a Householder QR factorization, otherwise the singular value
+// decomposition is used to find the minimum norm solution.
+// https://en.wikipedia.org/wiki/Linear_least_squares
+func (a Matrix[T]) LeastSquares(err *error, b Matrix[T]) LeastSquares[T] {
+	// Avoid hiding previous errors.
+	if *err != nil {
+		return LeastSquares[T]{}
+	}
+
+	if a.Dimensions.Height != b.Dimensions.Height {
+		*err = errors.New("cannot solve due to incompatible dimensions")
+		return LeastSquares[T]{}
+	}
+	if !a.isFinite() || !b.isFinite() {
+		*err = errors.New("cannot solve a system with non-finite values")
+		return LeastSquares[T]{}
+	}
+
+	m := a.Dimensions.Height
+	n := a.Dimensions.Width
+	size := m
+	if n > size {
+		size = n
+	}
+	tolerance := float64(size) * math.Pow(2, -52)
+
+	av := a.float64Values()
+	bv := b.float64Values()
+	x, rank, cond := leastSquaresQR(av, bv, tolerance)
+	if x == nil {
+		var ok bool
+		x, rank, cond, ok = leastSquaresSVD(av, bv, tolerance)
+		if !ok {
+			*err = errors.New("cannot solve, singular value decomposition did not converge")
+			return LeastSquares[T]{}
+		}
+	}
+
+	r := residual(av, x, bv)
+	residuals := make([]float64, b.Dimensions.Width)
+	for j := range r {
+		for i := range r[j] {
+			residuals[i] = math.Hypot(residuals[i], r[j][i])
+		}
+	}
+
+	return LeastSquares[T]{
+		X:         fromFloat64[T](x),
+		Residuals: residuals,
+		Rank:      rank,
+		Condition: cond,
+	}
+}
+
+// leastSquaresQR solves the least-squares problem with a QR factorization.
+// The singular values of R are those of a, so they decide the rank and the
+// condition number, since the diagonal of R does not reveal rank deficiency
+// without column pivoting. It returns a nil solution if a has fewer rows than
+// columns, is rank deficient, or the SVD of R did not converge, in which case
+// the SVD of a must be used instead.
+func leastSquaresQR(a, b [][]float64, tolerance float64) ([][]float64, int, float64) {
+	m := len(a)
+	n := len(a[0])
+	if m < n {
+		return nil, 0, 0
+	}
+
+	// Copy a, since the factorization works in place.
+	c := make([][]float64, m)
+	for j := range a {
+		c[j] = make([]float64, n)
+		copy(c[j], a[j])
+	}
+	q, r := householderQR(c)
+
+	_, sigma, _, ok := svd(r[:n], false)
+	if !ok || sigma[n-1] == 0 || sigma[n-1] <= tolerance*sigma[0] {
+		return nil, 0, 0
+	}
+
+	// Solve R * X = Q' * B using the first n rows.
+	x := make([][]float64, n)
+	for j := 0; j < n; j++ {
+		x[j] = make([]float64, len(b[0]))
+		for i := range x[j] {
+			for k := 0; k < m; k++ {
+				x[j][i] += q[k][j] * b[k][i]
+			}
+		}
+	}
+	for j := n - 1; j >= 0; j-- {
+		for k := j + 1; k < n; k++ {
+			for i := range x[j] {
+				x[j][i] -= r[j][k] * x[k][i]
+			}
+		}
+		for i := range x[j] {
+			x[j][i] /= r[j][j]
+		}
+	}
+
+	return x, n, sigma[0] / sigma[n-1]
+}
+
+// leastSquaresSVD finds the minimum norm least-squares solution with the
+// pseudo-inverse, returning the numerical rank and condition number, and
+// whether the singular value decomposition converged.
+func leastSquaresSVD(a, b [][]float64, tolerance float64) ([][]float64, int, float64, bool) {
+	_, s, _, ok := svd(a, false)
+	if !ok {
+		return nil, 0, 0, false
+	}
+	rank := 0
+	for _, v := range s {
+		if v > 0 && v > tolerance*s[0] {
+			rank++
+		}
+	}
+
+	cond := math.Inf(1)
+	if rank == len(s) {
+		cond = s[0] / s[rank-1]
+	}
+
+	p, ok := pseudoInverse(a, tolerance)
+	if !ok {
+		return nil, 0, 0, false
+	}
+	x := make([][]float64, len(p))
+	for j := range p {
+		x[j] = make([]float64, len(b[0]))
+		for i := range x[j] {
+			for k := range b {
+				x[j][i] += p[j][k] * b[k][i]
+			}
+		}
+	}
+	return x, rank, cond, true
+}
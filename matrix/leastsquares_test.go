@@ -0,0 +1,127 @@
+package matrix
+
+import (
+	"math"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestLeastSquares(t *testing.T) {
+	var err error
+
+	// Exact line fit y = 2x + 1
+	a := New(&err, []float64{0, 1}, []float64{1, 1}, []float64{2, 1}, []float64{3, 1})
+	assert.NilError(t, err)
+	b := New(&err, []float64{1}, []float64{3}, []float64{5}, []float64{7})
+	assert.NilError(t, err)
+	f := a.LeastSquares(&err, b)
+	assert.NilError(t, err)
+	r := New(&err, []float64{2}, []float64{1})
+	assert.NilError(t, err)
+	assert.Check(t, f.X.ApproxEqual(r, 0.0001))
+	assert.Check(t, f.Residuals[0] < 0.0001)
+	assert.Equal(t, f.Rank, 2)
+	assert.Check(t, f.Condition >= 1 && !math.IsInf(f.Condition, 1))
+	cond := a.ConditionNumber(&err, SpectralNorm)
+	assert.NilError(t, err)
+	assert.Check(t, math.Abs(f.Condition-cond) <= 1e-12*cond)
+
+	// Noisy line fit matches the normal equations
+	b = New(&err, []float64{1.1}, []float64{2.9}, []float64{5.2}, []float64{6.8})
+	assert.NilError(t, err)
+	f = a.LeastSquares(&err, b)
+	assert.NilError(t, err)
+	at := a.Transpose(&err)
+	r = at.Multiply(&err, a).Solve(&err, at.Multiply(&err, b))
+	assert.NilError(t, err)
+	assert.Check(t, f.X.ApproxEqual(r, 0.0001))
+	res := a.Multiply(&err, f.X).Subtract(&err, b)
+	assert.NilError(t, err)
+	norm := 0.0
+	for _, row := range res.Values {
+		norm = math.Hypot(norm, row[0])
+	}
+	assert.Check(t, math.Abs(f.Residuals[0]-norm) < 0.0001)
+
+	// Multiple right-hand sides
+	b = New(&err, []float64{1, 0}, []float64{3, 1}, []float64{5, 2}, []float64{7, 3})
+	assert.NilError(t, err)
+	f = a.LeastSquares(&err, b)
+	assert.NilError(t, err)
+	r = New(&err, []float64{2, 1}, []float64{1, 0})
+	assert.NilError(t, err)
+	assert.Check(t, f.X.ApproxEqual(r, 0.0001))
+	assert.Equal(t, len(f.Residuals), 2)
+}
+
+func TestLeastSquaresRankDeficient(t *testing.T) {
+	var err error
+
+	// Duplicate columns give the minimum norm solution.
+	a := New(&err, []float64{1, 1}, []float64{2, 2}, []float64{3, 3})
+	assert.NilError(t, err)
+	b := New(&err, []float64{2}, []float64{4}, []float64{6})
+	assert.NilError(t, err)
+	f := a.LeastSquares(&err, b)
+	assert.NilError(t, err)
+	r := New(&err, []float64{1}, []float64{1})
+	assert.NilError(t, err)
+	assert.Check(t, f.X.ApproxEqual(r, 0.0001))
+	assert.Equal(t, f.Rank, 1)
+	assert.Check(t, math.IsInf(f.Condition, 1))
+
+	// Underdetermined
+	a = New(&err, []float64{1, 1})
+	assert.NilError(t, err)
+	b = New(&err, []float64{2})
+	assert.NilError(t, err)
+	f = a.LeastSquares(&err, b)
+	assert.NilError(t, err)
+	assert.Check(t, f.X.ApproxEqual(r, 0.0001))
+	assert.Equal(t, f.Rank, 1)
+	assert.Check(t, f.Residuals[0] < 0.0001)
+}
+
+func TestLeastSquaresHiddenRankDeficiency(t *testing.T) {
+	var err error
+
+	// Upper triangular with unit diagonal and -1 above it. QR leaves the
+	// diagonal unchanged, but the smallest singular value is about 2^-n.
+	n := 60
+	values := make([][]float64, n)
+	ones := make([][]float64, n)
+	for j := 0; j < n; j++ {
+		values[j] = make([]float64, n)
+		values[j][j] = 1
+		for i := j + 1; i < n; i++ {
+			values[j][i] = -1
+		}
+		ones[j] = []float64{1}
+	}
+	a := New(&err, values...)
+	assert.NilError(t, err)
+	b := New(&err, ones...)
+	assert.NilError(t, err)
+	f := a.LeastSquares(&err, b)
+	assert.NilError(t, err)
+	assert.Equal(t, f.Rank, n-1)
+	assert.Check(t, math.IsInf(f.Condition, 1))
+}
+
+func TestLeastSquaresErrors(t *testing.T) {
+	var err error
+
+	a := New(&err, []float64{1, 2}, []float64{3, 4}, []float64{5, 6})
+	assert.NilError(t, err)
+	b := New(&err, []float64{1}, []float64{2})
+	assert.NilError(t, err)
+	_ = a.LeastSquares(&err, b)
+	assert.ErrorContains(t, err, "cannot solve due to incompatible dimensions")
+	err = nil
+
+	b = New(&err, []float64{1}, []float64{math.NaN()}, []float64{2})
+	assert.NilError(t, err)
+	_ = a.LeastSquares(&err, b)
+	assert.ErrorContains(t, err, "cannot solve a system with non-finite values")
+}
@@ -0,0 +1,242 @@
+package matrix
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"golang.org/x/exp/constraints"
+)
+
+// LU is the LU factorization of a square matrix with partial pivoting,
+// such that P * A = L * U. The factors are floating point for every element
+// type, since the multipliers of an integer matrix are generally not integers.
+type LU[T constraints.Integer | constraints.Float] struct {
+	// L is unit lower triangular.
+	L Matrix[float64]
+	// U is upper triangular.
+	U Matrix[float64]
+	// P is the row permutation matrix.
+	P Matrix[float64]
+
+	lu    [][]float64
+	pivot []int
+	sign  float64
+	limit float64
+}
+
+// LU factorizes a square matrix using Gaussian elimination with partial pivoting.
+// The factorization is kept in floating point so it can be reused to calculate
+// the determinant, the inverse, or solutions for many right-hand sides.
+func (a Matrix[T]) LU(err *error) LU[T] {
+	return a.LUWithTolerance(err, DefaultPivotTolerance)
+}
+
+// LUWithTolerance factorizes a square matrix as with LU. Inverse and Solve
+// report the matrix as singular if a pivot is not greater than tolerance
+// relative to the largest magnitude in the matrix.
+func (a Matrix[T]) LUWithTolerance(err *error, tolerance float64) LU[T] {
+	// Avoid hiding previous errors.
+	if *err != nil {
+		return LU[T]{}
+	}
+
+	// Check the matrix is square.
+	if a.Dimensions.Height != a.Dimensions.Width {
+		*err = errors.New("cannot calculate the LU factorization of a non-square matrix")
+		return LU[T]{}
+	}
+
+	if tolerance < 0 {
+		*err = errors.New("pivot tolerance cannot be negative")
+		return LU[T]{}
+	}
+
+	n := a.Dimensions.Width
+	lu := a.float64Values()
+	limit := tolerance * maxAbs(lu)
+	pivot := make([]int, n)
+	for j := 0; j < n; j++ {
+		pivot[j] = j
+	}
+
+	// https://en.wikipedia.org/wiki/LU_decomposition#Using_Gaussian_elimination
+	sign := 1.0
+	for k := 0; k < n; k++ {
+		// Select the row with the largest pivot candidate.
+		p := k
+		for j := k + 1; j < n; j++ {
+			if math.Abs(lu[j][k]) > math.Abs(lu[p][k]) {
+				p = j
+			}
+		}
+		if p != k {
+			lu[k], lu[p] = lu[p], lu[k]
+			pivot[k], pivot[p] = pivot[p], pivot[k]
+			sign = -sign
+		}
+
+		// A zero column leaves nothing to eliminate.
+		if lu[k][k] == 0 {
+			continue
+		}
+
+		// Store the multipliers below the diagonal and update the remaining rows.
+		for j := k + 1; j < n; j++ {
+			lu[j][k] /= lu[k][k]
+			f := lu[j][k]
+			for i := k + 1; i < n; i++ {
+				lu[j][i] -= f * lu[k][i]
+			}
+		}
+	}
+
+	l := make([][]float64, n)
+	u := make([][]float64, n)
+	p := make([][]float64, n)
+	for j := 0; j < n; j++ {
+		l[j] = make([]float64, n)
+		u[j] = make([]float64, n)
+		p[j] = make([]float64, n)
+		for i := 0; i < n; i++ {
+			switch {
+			case i < j:
+				l[j][i] = lu[j][i]
+			case i == j:
+				l[j][i] = 1
+				u[j][i] = lu[j][i]
+			default:
+				u[j][i] = lu[j][i]
+			}
+		}
+		p[j][pivot[j]] = 1
+	}
+
+	return LU[T]{
+		L:     fromFloat64[float64](l),
+		U:     fromFloat64[float64](u),
+		P:     fromFloat64[float64](p),
+		lu:    lu,
+		pivot: pivot,
+		sign:  sign,
+		limit: limit,
+	}
+}
+
+// Determinant calculates the determinant of the factorized matrix.
+func (f LU[T]) Determinant(err *error) T {
+	// Avoid hiding previous errors.
+	if *err != nil {
+		return 0
+	}
+
+	det := f.sign
+	for k := range f.lu {
+		det *= f.lu[k][k]
+	}
+	return fromFloat64Value[T](det)
+}
+
+// Inverse calculates the inverse of the factorized matrix.
+func (f LU[T]) Inverse(err *error) Matrix[T] {
+	// Avoid hiding previous errors.
+	if *err != nil {
+		return Matrix[T]{}
+	}
+
+	*err = f.pivotError("invert")
+	if *err != nil {
+		return Matrix[T]{}
+	}
+
+	return fromFloat64[T](f.solve(identity(len(f.lu))))
+}
+
+// Solve solves A * X = B for X using the factorization of A.
+// B may have any number of columns.
+func (f LU[T]) Solve(err *error, b Matrix[T]) Matrix[T] {
+	// Avoid hiding previous errors.
+	if *err != nil {
+		return Matrix[T]{}
+	}
+
+	if b.Dimensions.Height != len(f.lu) {
+		*err = errors.New("cannot solve due to incompatible dimensions")
+		return Matrix[T]{}
+	}
+
+	*err = f.pivotError("solve")
+	if *err != nil {
+		return Matrix[T]{}
+	}
+
+	return fromFloat64[T](f.solve(b.float64Values()))
+}
+
+// singular reports whether any pivot of U is too small to divide by.
+func (f LU[T]) singular() bool {
+	for k := range f.lu {
+		if math.Abs(f.lu[k][k]) <= f.limit {
+			return true
+		}
+	}
+	return false
+}
+
+// pivotError reports an error for the given operation if any pivot of U is
+// zero, or is too small to divide by.
+func (f LU[T]) pivotError(operation string) error {
+	nearly := false
+	for k := range f.lu {
+		if f.lu[k][k] == 0 {
+			return fmt.Errorf("cannot %s, matrix is singular", operation)
+		}
+		if math.Abs(f.lu[k][k]) <= f.limit {
+			nearly = true
+		}
+	}
+	if nearly {
+		return fmt.Errorf("cannot %s, matrix is nearly singular", operation)
+	}
+	return nil
+}
+
+// solve solves A * X = B using forward and back substitution.
+// The factorization must not be singular.
+func (f LU[T]) solve(b [][]float64) [][]float64 {
+	n := len(f.lu)
+	x := make([][]float64, n)
+	for j := 0; j < n; j++ {
+		x[j] = make([]float64, len(b[f.pivot[j]]))
+		copy(x[j], b[f.pivot[j]])
+	}
+
+	// Solve L * Y = P * B.
+	for j := 0; j < n; j++ {
+		for k := 0; k < j; k++ {
+			if f.lu[j][k] == 0 {
+				continue
+			}
+			for i := range x[j] {
+				x[j][i] -= f.lu[j][k] * x[k][i]
+			}
+		}
+	}
+
+	// Solve U * X = Y.
+	for j := n - 1; j >= 0; j-- {
+		for k := j + 1; k < n; k++ {
+			if f.lu[j][k] == 0 {
+				continue
+			}
+			for i := range x[j] {
+				x[j][i] -= f.lu[j][k] * x[k][i]
+			}
+		}
+		for i := range x[j] {
+			x[j][i] /= f.lu[j][j]
+		}
+	}
+
+	return x
+}
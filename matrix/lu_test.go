@@ -0,0 +1,179 @@
+package matrix
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestLU(t *testing.T) {
+	var err error
+
+	// 3x3 requiring a row swap - validate P * A = L * U
+	a := New(&err, []float64{1, 2, 3}, []float64{4, 5, 6}, []float64{7, 8, 10})
+	assert.NilError(t, err)
+	f := a.LU(&err)
+	assert.NilError(t, err)
+	pa := f.P.Multiply(&err, a)
+	assert.NilError(t, err)
+	lu := f.L.Multiply(&err, f.U)
+	assert.NilError(t, err)
+	assert.Check(t, pa.ApproxEqual(lu, 0.0001))
+
+	// L is unit lower triangular and U is upper triangular.
+	for j := 0; j < 3; j++ {
+		assert.Equal(t, f.L.Values[j][j], 1.0)
+		for i := j + 1; i < 3; i++ {
+			assert.Equal(t, f.L.Values[j][i], 0.0)
+			assert.Equal(t, f.U.Values[i][j], 0.0)
+		}
+	}
+
+	// Singular matrices can still be factorized.
+	a = New(&err, []float64{1, 2}, []float64{2, 4})
+	assert.NilError(t, err)
+	f = a.LU(&err)
+	assert.NilError(t, err)
+	lu = f.L.Multiply(&err, f.U)
+	assert.NilError(t, err)
+	pa = f.P.Multiply(&err, a)
+	assert.NilError(t, err)
+	assert.Check(t, pa.ApproxEqual(lu, 0.0001))
+
+	// Integer matrices have fractional multipliers.
+	b := New(&err, []int{2, 1}, []int{4, 3})
+	assert.NilError(t, err)
+	g := b.LU(&err)
+	assert.NilError(t, err)
+	assert.Equal(t, g.L.Values[1][0], 0.5)
+	lu = g.L.Multiply(&err, g.U)
+	assert.NilError(t, err)
+	pa = g.P.Multiply(&err, New(&err, []float64{2, 1}, []float64{4, 3}))
+	assert.NilError(t, err)
+	assert.Check(t, pa.Equal(lu))
+}
+
+func TestLUDeterminant(t *testing.T) {
+	var err error
+
+	a := New(&err, []float64{1, 2, 3}, []float64{4, 5, 6}, []float64{7, 8, 10})
+	assert.NilError(t, err)
+	det := a.LU(&err).Determinant(&err)
+	assert.NilError(t, err)
+	assert.Check(t, det > -3.0001 && det < -2.9999)
+
+	b := New(&err, []int{2, 0}, []int{0, 3})
+	assert.NilError(t, err)
+	assert.Equal(t, b.LU(&err).Determinant(&err), 6)
+	assert.NilError(t, err)
+
+	c := New(&err, []float64{1, 2}, []float64{2, 4})
+	assert.NilError(t, err)
+	assert.Equal(t, c.LU(&err).Determinant(&err), 0.0)
+	assert.NilError(t, err)
+}
+
+func TestLUSolve(t *testing.T) {
+	var err error
+
+	a := New(&err, []float64{2, 1, 1}, []float64{4, -6, 0}, []float64{-2, 7, 2})
+	assert.NilError(t, err)
+	f := a.LU(&err)
+	assert.NilError(t, err)
+
+	// Single right-hand side
+	b := New(&err, []float64{5}, []float64{-2}, []float64{9})
+	assert.NilError(t, err)
+	x := f.Solve(&err, b)
+	assert.NilError(t, err)
+	r := New(&err, []float64{1}, []float64{1}, []float64{2})
+	assert.NilError(t, err)
+	assert.Check(t, x.ApproxEqual(r, 0.0001))
+
+	// Multiple right-hand sides reusing the factorization
+	b = New(&err, []float64{5, 4}, []float64{-2, 4}, []float64{9, -2})
+	assert.NilError(t, err)
+	x = f.Solve(&err, b)
+	assert.NilError(t, err)
+	ax := a.Multiply(&err, x)
+	assert.NilError(t, err)
+	assert.Check(t, ax.ApproxEqual(b, 0.0001))
+}
+
+func TestLUInverse(t *testing.T) {
+	var err error
+
+	a := New(&err, []float64{0, 2, 1}, []float64{1, 0, 3}, []float64{4, 1, 0})
+	assert.NilError(t, err)
+	inv := a.LU(&err).Inverse(&err)
+	assert.NilError(t, err)
+	r := a.Inverse(&err)
+	assert.NilError(t, err)
+	assert.Check(t, inv.ApproxEqual(r, 0.0001))
+}
+
+func TestLUErrors(t *testing.T) {
+	var err error
+
+	a := New(&err, []float64{1, 2, 3}, []float64{4, 5, 6})
+	assert.NilError(t, err)
+	_ = a.LU(&err)
+	assert.ErrorContains(t, err, "cannot calculate the LU factorization of a non-square matrix")
+	err = nil
+
+	a = New(&err, []float64{1, 2}, []float64{2, 4})
+	assert.NilError(t, err)
+	f := a.LU(&err)
+	assert.NilError(t, err)
+	_ = f.Inverse(&err)
+	assert.ErrorContains(t, err, "cannot invert, matrix is singular")
+	err = nil
+
+	b := New(&err, []float64{1}, []float64{2})
+	assert.NilError(t, err)
+	_ = f.Solve(&err, b)
+	assert.ErrorContains(t, err, "cannot solve, matrix is singular")
+	err = nil
+
+	b = New(&err, []float64{1}, []float64{2}, []float64{3})
+	assert.NilError(t, err)
+	_ = f.Solve(&err, b)
+	assert.ErrorContains(t, err, "cannot solve due to incompatible dimensions")
+	err = nil
+
+	a = New(&err, []float64{1e13, 0}, []float64{0, 1})
+	assert.NilError(t, err)
+	f = a.LU(&err)
+	assert.NilError(t, err)
+	_ = f.Inverse(&err)
+	assert.ErrorContains(t, err, "cannot invert, matrix is nearly singular")
+	err = nil
+
+	_ = f.Solve(&err, New(&err, []float64{1}, []float64{2}))
+	assert.ErrorContains(t, err, "cannot solve, matrix is nearly singular")
+	err = nil
+
+	_ = a.LUWithTolerance(&err, -1)
+	assert.ErrorContains(t, err, "pivot tolerance cannot be negative")
+}
+
+func TestLUWithTolerance(t *testing.T) {
+	var err error
+
+	a := New(&err, []float64{1e13, 0}, []float64{0, 1})
+	assert.NilError(t, err)
+	f := a.LUWithTolerance(&err, 0)
+	assert.NilError(t, err)
+	inv := f.Inverse(&err)
+	assert.NilError(t, err)
+	assert.Check(t, inv.Equal(New(&err, []float64{1e-13, 0}, []float64{0, 1})))
+	x := f.Solve(&err, New(&err, []float64{1e13}, []float64{2}))
+	assert.NilError(t, err)
+	assert.Check(t, x.Equal(New(&err, []float64{1}, []float64{2})))
+
+	// Exactly singular matrices are still reported.
+	a = New(&err, []float64{1, 2}, []float64{2, 4})
+	assert.NilError(t, err)
+	_ = a.LUWithTolerance(&err, 0).Inverse(&err)
+	assert.ErrorContains(t, err, "cannot invert, matrix is singular")
+}
@@ -172,6 +172,57 @@ func (a Matrix[T]) Multiply(err *error, b Matrix[T]) Matrix[T] {
 	}
 }
 
+// Power raises a square matrix to the nth power using repeated squaring.
+// Negative powers of floating point matrices raise the inverse to the power -n.
+// https://en.wikipedia.org/wiki/Exponentiation_by_squaring
+func (a Matrix[T]) Power(err *error, n int) Matrix[T] {
+	// Avoid hiding previous errors.
+	if *err != nil {
+		return Matrix[T]{}
+	}
+
+	// Check the matrix is square.
+	if a.Dimensions.Height != a.Dimensions.Width {
+		*err = errors.New("cannot raise a non-square matrix to a power")
+		return Matrix[T]{}
+	}
+
+	base := a
+	if n < 0 {
+		if isInteger[T]() {
+			*err = errors.New("cannot raise an integer matrix to a negative power")
+			return Matrix[T]{}
+		}
+		// -math.MinInt overflows.
+		if n == math.MinInt {
+			*err = errors.New("power is out of range")
+			return Matrix[T]{}
+		}
+		base = a.Inverse(err)
+		n = -n
+	}
+
+	return power(err, base, n, NewIdentity[T](err, a.Dimensions), Matrix[T].Multiply)
+}
+
+// power calculates identity * base^n for n >= 0 using repeated squaring with the
+// passed multiplication.
+func power[T constraints.Integer | constraints.Float](err *error, base Matrix[T], n int, identity Matrix[T], multiply func(Matrix[T], *error, Matrix[T]) Matrix[T]) Matrix[T] {
+	m := identity
+	for ; n > 0 && *err == nil; n >>= 1 {
+		if n&1 == 1 {
+			m = multiply(m, err, base)
+		}
+		if n > 1 {
+			base = multiply(base, err, base)
+		}
+	}
+	if *err != nil {
+		return Matrix[T]{}
+	}
+	return m
+}
+
 // Add a matrix to another one.
 // The dimensions of the matrices must match.
 func (a Matrix[T]) Add(err *error, b Matrix[T]) Matrix[T] {
@@ -220,9 +271,23 @@ func (a Matrix[T]) Subtract(err *error, b Matrix[T]) Matrix[T] {
 	return m
 }
 
-// Inverse a matrix
-// TODO: figure out how to handle integers properly.
+// DefaultPivotTolerance is the relative pivot tolerance used by Inverse.
+// A pivot whose magnitude is not greater than the tolerance multiplied by the
+// largest magnitude in the matrix is treated as zero.
+const DefaultPivotTolerance = 1e-12
+
+// Inverse a matrix using Gauss-Jordan elimination with partial pivoting.
+// Integer matrices are inverted exactly, and an error is reported if the inverse
+// is not integral. Use Rational to calculate such inverses.
 func (a Matrix[T]) Inverse(err *error) Matrix[T] {
+	return a.InverseWithTolerance(err, DefaultPivotTolerance)
+}
+
+// InverseWithTolerance inverts a matrix using Gauss-Jordan elimination with
+// partial pivoting. The matrix is reported as singular if a pivot is not greater
+// than tolerance relative to the largest magnitude in the matrix.
+// Integer matrices are inverted exactly as with Inverse, ignoring the tolerance.
+func (a Matrix[T]) InverseWithTolerance(err *error, tolerance float64) Matrix[T] {
 	// Avoid hiding previous errors.
 	if *err != nil {
 		return Matrix[T]{}
@@ -234,29 +299,74 @@ func (a Matrix[T]) Inverse(err *error) Matrix[T] {
 		return Matrix[T]{}
 	}
 
-	if a.Dimensions.Width == 2 {
-		// Invert a 2x2 matrix.
-		// https://en.wikipedia.org/wiki/Invertible_matrix#Inversion_of_2_%C3%97_2_matrices
-		detA := (a.Values[0][0] * a.Values[1][1]) - (a.Values[0][1] * a.Values[1][0])
-		if detA == 0 {
+	if tolerance < 0 {
+		*err = errors.New("pivot tolerance cannot be negative")
+		return Matrix[T]{}
+	}
+
+	if isInteger[T]() {
+		inv := a.Rational(err).Inverse(err)
+		if *err != nil {
+			return Matrix[T]{}
+		}
+		for _, row := range inv.Values {
+			for _, v := range row {
+				if !v.IsInt() {
+					*err = errors.New("cannot invert, inverse of an integer matrix is not integral")
+					return Matrix[T]{}
+				}
+			}
+		}
+		return fromRat[T](err, inv.Values)
+	}
+
+	// Reduce [A | I] to [I | A^-1].
+	// https://en.wikipedia.org/wiki/Gaussian_elimination#Finding_the_inverse_of_a_matrix
+	n := a.Dimensions.Width
+	values := a.float64Values()
+	inv := identity(n)
+
+	limit := tolerance * maxAbs(values)
+	for k := 0; k < n; k++ {
+		// Select the row with the largest pivot candidate.
+		p := k
+		for j := k + 1; j < n; j++ {
+			if math.Abs(values[j][k]) > math.Abs(values[p][k]) {
+				p = j
+			}
+		}
+		if values[p][k] == 0 {
 			*err = errors.New("cannot invert, matrix is singular")
 			return Matrix[T]{}
 		}
-		detA = 1 / detA
-		m := New(
-			err,
-			[]T{a.Values[1][1], -a.Values[0][1]},
-			[]T{-a.Values[1][0], a.Values[0][0]},
-		)
-		if *err != nil {
+		if math.Abs(values[p][k]) <= limit {
+			*err = errors.New("cannot invert, matrix is nearly singular")
 			return Matrix[T]{}
 		}
-		return m.MultiplyScalar(err, detA)
-	} else {
-		// Invert a n-n matrix using Gausian Elimination.
-		*err = errors.New("Inverse not implemented for matrixes width dimensions above 2x2")
-		return Matrix[T]{}
+		values[k], values[p] = values[p], values[k]
+		inv[k], inv[p] = inv[p], inv[k]
+
+		// Scale the pivot row so the pivot is 1.
+		pivot := values[k][k]
+		for i := 0; i < n; i++ {
+			values[k][i] /= pivot
+			inv[k][i] /= pivot
+		}
+
+		// Eliminate the pivot column from every other row.
+		for j := 0; j < n; j++ {
+			f := values[j][k]
+			if j == k || f == 0 {
+				continue
+			}
+			for i := 0; i < n; i++ {
+				values[j][i] -= f * values[k][i]
+				inv[j][i] -= f * inv[k][i]
+			}
+		}
 	}
+
+	return fromFloat64[T](inv)
 }
 
 // Transpose calculates the transpose of a Matrix.
@@ -333,3 +443,93 @@ func (a Matrix[T]) ApproxEqual(b Matrix[T], errorMargin T) bool {
 func (a Matrix[T]) String() string {
 	return fmt.Sprint(a.Values)
 }
+
+// float64Values returns a copy of the matrix values converted to float64.
+func (a Matrix[T]) float64Values() [][]float64 {
+	values := make([][]float64, a.Dimensions.Height)
+	for j := 0; j < a.Dimensions.Height; j++ {
+		values[j] = make([]float64, a.Dimensions.Width)
+		for i := 0; i < a.Dimensions.Width; i++ {
+			values[j][i] = float64(a.Values[j][i])
+		}
+	}
+	return values
+}
+
+// fromFloat64 creates a Matrix from float64 values.
+// Values are rounded to the nearest integer when T is an integer type.
+func fromFloat64[T constraints.Integer | constraints.Float](values [][]float64) Matrix[T] {
+	height := len(values)
+	width := 0
+	if height > 0 {
+		width = len(values[0])
+	}
+
+	m := make([][]T, height)
+	for j := 0; j < height; j++ {
+		m[j] = make([]T, width)
+		for i := 0; i < width; i++ {
+			m[j][i] = fromFloat64Value[T](values[j][i])
+		}
+	}
+
+	return Matrix[T]{
+		Dimensions: Dimension{
+			Width:  width,
+			Height: height,
+		},
+		Values: m,
+	}
+}
+
+// fromFloat64Value converts a float64 to T.
+// The value is rounded to the nearest integer when T is an integer type.
+func fromFloat64Value[T constraints.Integer | constraints.Float](v float64) T {
+	if isInteger[T]() {
+		return T(math.Round(v))
+	}
+	return T(v)
+}
+
+// isFinite reports whether every value of the matrix is neither infinite nor NaN.
+func (a Matrix[T]) isFinite() bool {
+	if isInteger[T]() {
+		return true
+	}
+	for _, row := range a.Values {
+		for _, v := range row {
+			if math.IsInf(float64(v), 0) || math.IsNaN(float64(v)) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// isInteger reports whether T is an integer type.
+func isInteger[T constraints.Integer | constraints.Float]() bool {
+	return T(1)/T(2) == 0
+}
+
+// identity returns an n×n identity matrix of float64 values.
+func identity(n int) [][]float64 {
+	values := make([][]float64, n)
+	for j := 0; j < n; j++ {
+		values[j] = make([]float64, n)
+		values[j][j] = 1
+	}
+	return values
+}
+
+// maxAbs returns the largest magnitude in values.
+func maxAbs(values [][]float64) float64 {
+	max := 0.0
+	for _, row := range values {
+		for _, v := range row {
+			if math.Abs(v) > max {
+				max = math.Abs(v)
+			}
+		}
+	}
+	return max
+}
@@ -1,6 +1,7 @@
 package matrix
 
 import (
+	"math"
 	"testing"
 
 	"gotest.tools/v3/assert"
@@ -227,6 +228,79 @@ func TestMultiply(t *testing.T) {
 	assert.Check(t, m.Equal(r))
 }
 
+func TestPower(t *testing.T) {
+	var err error
+
+	// Fibonacci numbers
+	a := New(&err, []int{1, 1}, []int{1, 0})
+	assert.NilError(t, err)
+	m := a.Power(&err, 10)
+	assert.NilError(t, err)
+	r := New(&err, []int{89, 55}, []int{55, 34})
+	assert.NilError(t, err)
+	assert.Check(t, m.Equal(r))
+
+	// Zero and first powers
+	m = a.Power(&err, 0)
+	assert.NilError(t, err)
+	r = New(&err, []int{1, 0}, []int{0, 1})
+	assert.NilError(t, err)
+	assert.Check(t, m.Equal(r))
+
+	m = a.Power(&err, 1)
+	assert.NilError(t, err)
+	assert.Check(t, m.Equal(a))
+
+	// Matches repeated multiplication
+	b := New(&err, []float64{0.9, 0.1, 0}, []float64{0.2, 0.7, 0.1}, []float64{0, 0.3, 0.7})
+	assert.NilError(t, err)
+	p := b.Power(&err, 7)
+	assert.NilError(t, err)
+	s := b
+	for k := 1; k < 7; k++ {
+		s = s.Multiply(&err, b)
+	}
+	assert.NilError(t, err)
+	assert.Check(t, p.ApproxEqual(s, 0.0001))
+
+	// Negative powers
+	b = New(&err, []float64{2, 0}, []float64{0, 4})
+	assert.NilError(t, err)
+	p = b.Power(&err, -2)
+	assert.NilError(t, err)
+	s = New(&err, []float64{0.25, 0}, []float64{0, 0.0625})
+	assert.NilError(t, err)
+	assert.Check(t, p.ApproxEqual(s, 0.0001))
+}
+
+func TestPowerErrors(t *testing.T) {
+	var err error
+
+	a := New(&err, []int{1, 2, 3})
+	assert.NilError(t, err)
+	_ = a.Power(&err, 2)
+	assert.ErrorContains(t, err, "cannot raise a non-square matrix to a power")
+	err = nil
+
+	a = New(&err, []int{1, 1}, []int{0, 1})
+	assert.NilError(t, err)
+	_ = a.Power(&err, -1)
+	assert.ErrorContains(t, err, "cannot raise an integer matrix to a negative power")
+	err = nil
+
+	b := New(&err, []float64{1, 2}, []float64{2, 4})
+	assert.NilError(t, err)
+	m := b.Power(&err, -1)
+	assert.ErrorContains(t, err, "cannot invert, matrix is singular")
+	assert.Check(t, m.Equal(Matrix[float64]{}))
+	err = nil
+
+	c := New(&err, []float64{2, 0}, []float64{0, 1})
+	assert.NilError(t, err)
+	_ = c.Power(&err, math.MinInt)
+	assert.ErrorContains(t, err, "power is out of range")
+}
+
 func TestAdd(t *testing.T) {
 	var err error
 
@@ -357,6 +431,107 @@ func TestInverse(t *testing.T) {
 	i = NewIdentity[float64](&err, a.Dimensions)
 	assert.NilError(t, err)
 	assert.Check(t, r.ApproxEqual(i, 0.0001))
+
+	// 3x3
+	a = New(&err, []float64{2, -1, 0}, []float64{-1, 2, -1}, []float64{0, -1, 2})
+	assert.NilError(t, err)
+	inv = a.Inverse(&err)
+	assert.NilError(t, err)
+	r = New(&err,
+		[]float64{3.0 / 4.0, 1.0 / 2.0, 1.0 / 4.0},
+		[]float64{1.0 / 2.0, 1, 1.0 / 2.0},
+		[]float64{1.0 / 4.0, 1.0 / 2.0, 3.0 / 4.0},
+	)
+	assert.NilError(t, err)
+	assert.Check(t, inv.ApproxEqual(r, 0.0001))
+
+	// 4x4 requiring row swaps - validate A-1 * A = I
+	a = New(&err,
+		[]float64{0, 2, 1, 4},
+		[]float64{1, 0, 3, 2},
+		[]float64{4, 1, 0, 1},
+		[]float64{2, 3, 1, 0},
+	)
+	assert.NilError(t, err)
+	inv = a.Inverse(&err)
+	assert.NilError(t, err)
+	r = inv.Multiply(&err, a)
+	assert.NilError(t, err)
+	i = NewIdentity[float64](&err, a.Dimensions)
+	assert.NilError(t, err)
+	assert.Check(t, r.ApproxEqual(i, 0.0001))
+
+	// 1x1
+	a = New(&err, []float64{4})
+	assert.NilError(t, err)
+	inv = a.Inverse(&err)
+	assert.NilError(t, err)
+	r = New(&err, []float64{0.25})
+	assert.NilError(t, err)
+	assert.Check(t, inv.ApproxEqual(r, 0.0001))
+
+	// 3x3 integer with an integer inverse
+	b := New(&err, []int{1, 2, 0}, []int{0, 1, 0}, []int{0, 0, 1})
+	assert.NilError(t, err)
+	binv := b.Inverse(&err)
+	assert.NilError(t, err)
+	s := New(&err, []int{1, -2, 0}, []int{0, 1, 0}, []int{0, 0, 1})
+	assert.NilError(t, err)
+	assert.Check(t, binv.Equal(s))
+}
+
+func TestInverseErrors(t *testing.T) {
+	var err error
+
+	// Non-square
+	a := New(&err, []float64{1, 2, 3}, []float64{4, 5, 6})
+	assert.NilError(t, err)
+	_ = a.Inverse(&err)
+	assert.ErrorContains(t, err, "cannot calculate the inverse of a non-square matrix")
+	err = nil
+
+	// Singular
+	a = New(&err, []float64{1, 2, 3}, []float64{2, 4, 6}, []float64{1, 0, 1})
+	assert.NilError(t, err)
+	m := a.Inverse(&err)
+	assert.ErrorContains(t, err, "cannot invert, matrix is")
+	assert.Check(t, m.Equal(Matrix[float64]{}))
+	err = nil
+
+	// Zero
+	a = NewZero[float64](&err, Dimension{Width: 3, Height: 3})
+	assert.NilError(t, err)
+	_ = a.Inverse(&err)
+	assert.ErrorContains(t, err, "cannot invert, matrix is singular")
+	err = nil
+
+	// Nearly singular with a configurable tolerance
+	a = New(&err, []float64{1, 1}, []float64{1, 1 + 1e-9})
+	assert.NilError(t, err)
+	_ = a.Inverse(&err)
+	assert.NilError(t, err)
+	_ = a.InverseWithTolerance(&err, 1e-6)
+	assert.ErrorContains(t, err, "cannot invert, matrix is nearly singular")
+	err = nil
+
+	// Negative tolerance
+	_ = a.InverseWithTolerance(&err, -1)
+	assert.ErrorContains(t, err, "pivot tolerance cannot be negative")
+	err = nil
+
+	// Integer matrix whose inverse is not integral
+	b := New(&err, []int{2, 0}, []int{0, 2})
+	assert.NilError(t, err)
+	n := b.Inverse(&err)
+	assert.ErrorContains(t, err, "cannot invert, inverse of an integer matrix is not integral")
+	assert.Check(t, n.Equal(Matrix[int]{}))
+	err = nil
+
+	// Singular integer matrix
+	b = New(&err, []int{1, 2}, []int{2, 4})
+	assert.NilError(t, err)
+	_ = b.Inverse(&err)
+	assert.ErrorContains(t, err, "cannot invert, matrix is singular")
 }
 
 func TestOperationErrors(t *testing.T) {
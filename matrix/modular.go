@@ -0,0 +1,552 @@
+package matrix
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"math/bits"
+
+	"golang.org/x/exp/constraints"
+)
+
+// ModMatrix is a matrix over the finite field GF(p) of integers modulo a prime p.
+// All operations, including division, are exact modulo p.
+// https://en.wikipedia.org/wiki/Finite_field
+type ModMatrix struct {
+	Dimensions Dimension
+	Modulus    uint64
+	// Values are reduced to the range [0, Modulus).
+	Values [][]uint64
+}
+
+// NewMod instantiates a ModMatrix over GF(p) with the passed values, which are
+// reduced modulo p.
+func NewMod(err *error, p uint64, values ...[]int64) ModMatrix {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return ModMatrix{}
+	}
+
+	height := len(values)
+	if height < 1 {
+		*err = errors.New("cannot create a Matrix with a dimension that is less than 1")
+		return ModMatrix{}
+	}
+
+	width := len(values[0])
+	if width < 1 {
+		*err = errors.New("cannot create a Matrix with a dimension that is less than 1")
+		return ModMatrix{}
+	}
+
+	if !isPrime(p) {
+		*err = errors.New("modulus must be prime")
+		return ModMatrix{}
+	}
+
+	m := make([][]uint64, height)
+	for j, row := range values {
+		if len(row) != width {
+			*err = errors.New("cannot create a Matrix with different row lengths")
+			return ModMatrix{}
+		}
+		m[j] = make([]uint64, width)
+		for i, v := range row {
+			m[j][i] = reduceMod(v, p)
+		}
+	}
+
+	return ModMatrix{
+		Dimensions: Dimension{
+			Width:  width,
+			Height: height,
+		},
+		Modulus: p,
+		Values:  m,
+	}
+}
+
+// Mod converts an integer Matrix to a ModMatrix over GF(p).
+func (a Matrix[T]) Mod(err *error, p uint64) ModMatrix {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return ModMatrix{}
+	}
+
+	if !isPrime(p) {
+		*err = errors.New("modulus must be prime")
+		return ModMatrix{}
+	}
+
+	modulus := new(big.Int).SetUint64(p)
+	m := make([][]uint64, a.Dimensions.Height)
+	for j := 0; j < a.Dimensions.Height; j++ {
+		m[j] = make([]uint64, a.Dimensions.Width)
+		for i := 0; i < a.Dimensions.Width; i++ {
+			v := float64(a.Values[j][i])
+			if !isInteger[T]() && (math.IsInf(v, 0) || math.IsNaN(v) || v != math.Trunc(v)) {
+				*err = errors.New("cannot reduce a non-integer value modulo p")
+				return ModMatrix{}
+			}
+			var x *big.Int
+			if isInteger[T]() {
+				x = bigInt(a.Values[j][i])
+			} else {
+				x, _ = big.NewFloat(v).Int(nil)
+			}
+			m[j][i] = x.Mod(x, modulus).Uint64()
+		}
+	}
+
+	return ModMatrix{
+		Dimensions: a.Dimensions,
+		Modulus:    p,
+		Values:     m,
+	}
+}
+
+// FromMod converts a ModMatrix to a Matrix using the representatives in the
+// range [0, p), reporting an error if a value does not fit in T.
+func FromMod[T constraints.Integer | constraints.Float](err *error, a ModMatrix) Matrix[T] {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return Matrix[T]{}
+	}
+
+	m := make([][]T, a.Dimensions.Height)
+	for j := range m {
+		m[j] = make([]T, a.Dimensions.Width)
+		for i := range m[j] {
+			v := a.Values[j][i]
+			m[j][i] = T(v)
+			if isInteger[T]() && (m[j][i] < 0 || uint64(m[j][i]) != v) {
+				*err = errors.New("result overflows the matrix element type")
+				return Matrix[T]{}
+			}
+		}
+	}
+
+	return Matrix[T]{
+		Dimensions: a.Dimensions,
+		Values:     m,
+	}
+}
+
+// MultiplyScalar multiplies the Matrix by a scalar.
+func (a ModMatrix) MultiplyScalar(err *error, x int64) ModMatrix {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return ModMatrix{}
+	}
+
+	s := reduceMod(x, a.Modulus)
+	m := a.Clone()
+	for j := 0; j < a.Dimensions.Height; j++ {
+		for i := 0; i < a.Dimensions.Width; i++ {
+			m.Values[j][i] = mulMod(m.Values[j][i], s, a.Modulus)
+		}
+	}
+	return m
+}
+
+// Multiply multiplies the matrix by another matrix.
+// The height of matix B must match the width of matrix A.
+func (a ModMatrix) Multiply(err *error, b ModMatrix) ModMatrix {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return ModMatrix{}
+	}
+
+	if a.Modulus != b.Modulus {
+		*err = errors.New("cannot combine matrices with different moduli")
+		return ModMatrix{}
+	}
+
+	// Check matrices can be multiplied.
+	if a.Dimensions.Width != b.Dimensions.Height {
+		*err = errors.New("cannot multiply matrices due to incompatible dimensions")
+		return ModMatrix{}
+	}
+
+	p := a.Modulus
+	width := b.Dimensions.Width
+	height := a.Dimensions.Height
+	m := make([][]uint64, height)
+	for j := 0; j < height; j++ {
+		m[j] = make([]uint64, width)
+		for x := 0; x < width; x++ {
+			sum := uint64(0)
+			for i := 0; i < a.Dimensions.Width; i++ {
+				sum = addMod(sum, mulMod(a.Values[j][i], b.Values[i][x], p), p)
+			}
+			m[j][x] = sum
+		}
+	}
+
+	return ModMatrix{
+		Dimensions: Dimension{
+			Width:  width,
+			Height: height,
+		},
+		Modulus: p,
+		Values:  m,
+	}
+}
+
+// Add a matrix to another one.
+// The dimensions of the matrices must match.
+func (a ModMatrix) Add(err *error, b ModMatrix) ModMatrix {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return ModMatrix{}
+	}
+
+	if a.Modulus != b.Modulus {
+		*err = errors.New("cannot combine matrices with different moduli")
+		return ModMatrix{}
+	}
+
+	// Check matrices can be added.
+	if a.Dimensions != b.Dimensions {
+		*err = errors.New("cannot add matrices due to incompatible dimensions")
+		return ModMatrix{}
+	}
+
+	m := a.Clone()
+	for j := 0; j < a.Dimensions.Height; j++ {
+		for i := 0; i < a.Dimensions.Width; i++ {
+			m.Values[j][i] = addMod(m.Values[j][i], b.Values[j][i], a.Modulus)
+		}
+	}
+	return m
+}
+
+// Subtract a matrix from another one.
+// The dimensions of the matrices must match.
+func (a ModMatrix) Subtract(err *error, b ModMatrix) ModMatrix {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return ModMatrix{}
+	}
+
+	if a.Modulus != b.Modulus {
+		*err = errors.New("cannot combine matrices with different moduli")
+		return ModMatrix{}
+	}
+
+	// Check matrices can be subtracted.
+	if a.Dimensions != b.Dimensions {
+		*err = errors.New("cannot subtract matrices due to incompatible dimensions")
+		return ModMatrix{}
+	}
+
+	m := a.Clone()
+	for j := 0; j < a.Dimensions.Height; j++ {
+		for i := 0; i < a.Dimensions.Width; i++ {
+			m.Values[j][i] = subMod(m.Values[j][i], b.Values[j][i], a.Modulus)
+		}
+	}
+	return m
+}
+
+// Inverse a matrix modulo p using Gauss-Jordan elimination.
+func (a ModMatrix) Inverse(err *error) ModMatrix {
+	// Avoid hiding previous errors.
+	if *err != nil {
+		return ModMatrix{}
+	}
+
+	// Check the matrix is square.
+	if a.Dimensions.Height != a.Dimensions.Width {
+		*err = errors.New("cannot calculate the inverse of a non-square matrix")
+		return ModMatrix{}
+	}
+
+	n := a.Dimensions.Width
+	i := make([][]uint64, n)
+	for j := 0; j < n; j++ {
+		i[j] = make([]uint64, n)
+		i[j][j] = 1
+	}
+
+	m, ok := a.reduce(i)
+	if !ok {
+		*err = errors.New("cannot invert, matrix is singular")
+		return ModMatrix{}
+	}
+	return m
+}
+
+// Solve solves A * X = B for X modulo p. B may have any number of columns.
+func (a ModMatrix) Solve(err *error, b ModMatrix) ModMatrix {
+	// Avoid hiding previous errors.
+	if *err != nil {
+		return ModMatrix{}
+	}
+
+	if a.Modulus != b.Modulus {
+		*err = errors.New("cannot combine matrices with different moduli")
+		return ModMatrix{}
+	}
+
+	// Check the system can be solved.
+	if a.Dimensions.Height != a.Dimensions.Width {
+		*err = errors.New("cannot solve a non-square system")
+		return ModMatrix{}
+	}
+	if a.Dimensions.Height != b.Dimensions.Height {
+		*err = errors.New("cannot solve due to incompatible dimensions")
+		return ModMatrix{}
+	}
+
+	m, ok := a.reduce(b.Clone().Values)
+	if !ok {
+		*err = errors.New("cannot solve, matrix is singular")
+		return ModMatrix{}
+	}
+	return m
+}
+
+// Determinant calculates the determinant of a square matrix modulo p.
+func (a ModMatrix) Determinant(err *error) uint64 {
+	// Avoid hiding previous errors.
+	if *err != nil {
+		return 0
+	}
+
+	// Check the matrix is square.
+	if a.Dimensions.Height != a.Dimensions.Width {
+		*err = errors.New("cannot calculate the determinant of a non-square matrix")
+		return 0
+	}
+
+	p := a.Modulus
+	n := a.Dimensions.Width
+	m := a.Clone().Values
+	det := uint64(1)
+	for k := 0; k < n; k++ {
+		r := -1
+		for j := k; j < n; j++ {
+			if m[j][k] != 0 {
+				r = j
+				break
+			}
+		}
+		if r < 0 {
+			return 0
+		}
+		if r != k {
+			m[k], m[r] = m[r], m[k]
+			det = subMod(0, det, p)
+		}
+		det = mulMod(det, m[k][k], p)
+
+		inv := invMod(m[k][k], p)
+		for j := k + 1; j < n; j++ {
+			if m[j][k] == 0 {
+				continue
+			}
+			f := mulMod(m[j][k], inv, p)
+			for i := k; i < n; i++ {
+				m[j][i] = subMod(m[j][i], mulMod(f, m[k][i], p), p)
+			}
+		}
+	}
+	return det
+}
+
+// RREF calculates the reduced row echelon form of a matrix modulo p.
+func (a ModMatrix) RREF(err *error) ModMatrix {
+	// Avoid hiding previous errors.
+	if *err != nil {
+		return ModMatrix{}
+	}
+
+	m := a.Clone()
+	rrefMod(m.Values, a.Modulus)
+	return m
+}
+
+// Rank calculates the number of linearly independent rows of a matrix modulo p.
+func (a ModMatrix) Rank(err *error) int {
+	// Avoid hiding previous errors.
+	if *err != nil {
+		return 0
+	}
+
+	return len(rrefMod(a.Clone().Values, a.Modulus))
+}
+
+// Transpose calculates the transpose of a Matrix.
+func (a ModMatrix) Transpose(err *error) ModMatrix {
+	values := make([][]uint64, a.Dimensions.Width)
+	for j := 0; j < a.Dimensions.Width; j++ {
+		values[j] = make([]uint64, a.Dimensions.Height)
+		for i := 0; i < a.Dimensions.Height; i++ {
+			values[j][i] = a.Values[i][j]
+		}
+	}
+
+	return ModMatrix{
+		Dimensions: Dimension{
+			Width:  a.Dimensions.Height,
+			Height: a.Dimensions.Width,
+		},
+		Modulus: a.Modulus,
+		Values:  values,
+	}
+}
+
+func (a ModMatrix) Clone() ModMatrix {
+	values := make([][]uint64, a.Dimensions.Height)
+	for j := 0; j < a.Dimensions.Height; j++ {
+		values[j] = make([]uint64, a.Dimensions.Width)
+		copy(values[j], a.Values[j])
+	}
+
+	return ModMatrix{
+		Dimensions: a.Dimensions,
+		Modulus:    a.Modulus,
+		Values:     values,
+	}
+}
+
+func (a ModMatrix) Equal(b ModMatrix) bool {
+	if a.Dimensions != b.Dimensions || a.Modulus != b.Modulus {
+		return false
+	}
+	for j := 0; j < a.Dimensions.Height; j++ {
+		for i := 0; i < a.Dimensions.Width; i++ {
+			if a.Values[j][i] != b.Values[j][i] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (a ModMatrix) String() string {
+	return fmt.Sprint(a.Values)
+}
+
+// reduce reduces [A | B] to [I | X] modulo p and returns X, reporting false if
+// A is singular. The values of b are overwritten.
+func (a ModMatrix) reduce(b [][]uint64) (ModMatrix, bool) {
+	n := a.Dimensions.Width
+	augmented := make([][]uint64, n)
+	for j := 0; j < n; j++ {
+		augmented[j] = append(append(make([]uint64, 0, n+len(b[j])), a.Values[j]...), b[j]...)
+	}
+
+	pivots := rrefMod(augmented, a.Modulus)
+	if len(pivots) < n || pivots[n-1] != n-1 {
+		return ModMatrix{}, false
+	}
+
+	values := make([][]uint64, n)
+	for j := 0; j < n; j++ {
+		values[j] = augmented[j][n:]
+	}
+	return ModMatrix{
+		Dimensions: Dimension{
+			Width:  len(b[0]),
+			Height: n,
+		},
+		Modulus: a.Modulus,
+		Values:  values,
+	}, true
+}
+
+// rrefMod reduces values to reduced row echelon form modulo p in place,
+// returning the pivot columns.
+func rrefMod(values [][]uint64, p uint64) []int {
+	m := len(values)
+	n := len(values[0])
+	pivots := []int{}
+
+	row := 0
+	for col := 0; col < n && row < m; col++ {
+		r := -1
+		for j := row; j < m; j++ {
+			if values[j][col] != 0 {
+				r = j
+				break
+			}
+		}
+		if r < 0 {
+			continue
+		}
+		values[row], values[r] = values[r], values[row]
+
+		inv := invMod(values[row][col], p)
+		for i := col; i < n; i++ {
+			values[row][i] = mulMod(values[row][i], inv, p)
+		}
+		for j := 0; j < m; j++ {
+			f := values[j][col]
+			if j == row || f == 0 {
+				continue
+			}
+			for i := col; i < n; i++ {
+				values[j][i] = subMod(values[j][i], mulMod(f, values[row][i], p), p)
+			}
+		}
+
+		pivots = append(pivots, col)
+		row++
+	}
+	return pivots
+}
+
+// isPrime reports whether p is prime.
+func isPrime(p uint64) bool {
+	// ProbablyPrime is exact for values below 2^64.
+	return new(big.Int).SetUint64(p).ProbablyPrime(0)
+}
+
+// reduceMod reduces v to the range [0, p).
+func reduceMod(v int64, p uint64) uint64 {
+	if v >= 0 {
+		return uint64(v) % p
+	}
+	// Negate using unsigned arithmetic so math.MinInt64 does not overflow.
+	return subMod(0, (-uint64(v))%p, p)
+}
+
+// addMod calculates (a + b) mod p for a, b < p.
+func addMod(a, b, p uint64) uint64 {
+	sum, carry := bits.Add64(a, b, 0)
+	if carry != 0 || sum >= p {
+		sum -= p
+	}
+	return sum
+}
+
+// subMod calculates (a - b) mod p for a, b < p.
+func subMod(a, b, p uint64) uint64 {
+	if a >= b {
+		return a - b
+	}
+	return p - (b - a)
+}
+
+// mulMod calculates (a * b) mod p for a, b < p.
+func mulMod(a, b, p uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	_, rem := bits.Div64(hi, lo, p)
+	return rem
+}
+
+// invMod calculates the multiplicative inverse of a modulo the prime p using
+// Fermat's little theorem, a^(p-2) mod p.
+func invMod(a, p uint64) uint64 {
+	result := uint64(1)
+	for e := p - 2; e > 0; e >>= 1 {
+		if e&1 == 1 {
+			result = mulMod(result, a, p)
+		}
+		a = mulMod(a, a, p)
+	}
+	return result
+}
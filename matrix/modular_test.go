@@ -0,0 +1,199 @@
+package matrix
+
+import (
+	"math"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestNewMod(t *testing.T) {
+	var err error
+
+	a := NewMod(&err, 7, []int64{8, -1}, []int64{14, 3})
+	assert.NilError(t, err)
+	assert.Equal(t, a.String(), "[[1 6] [0 3]]")
+	assert.Equal(t, a.Modulus, uint64(7))
+
+	b := NewMod(&err, 7, []int64{math.MinInt64})
+	assert.NilError(t, err)
+	assert.Equal(t, b.Values[0][0], uint64(6))
+
+	_ = NewMod(&err, 8, []int64{1})
+	assert.ErrorContains(t, err, "modulus must be prime")
+	err = nil
+
+	_ = NewMod(&err, 7)
+	assert.ErrorContains(t, err, "cannot create a Matrix with a dimension that is less than 1")
+	err = nil
+
+	_ = NewMod(&err, 7, []int64{1}, []int64{1, 2})
+	assert.ErrorContains(t, err, "cannot create a Matrix with different row lengths")
+}
+
+func TestModConversion(t *testing.T) {
+	var err error
+
+	a := New(&err, []int{-1, 30}, []int{5, 29})
+	assert.NilError(t, err)
+	m := a.Mod(&err, 29)
+	assert.NilError(t, err)
+	assert.Equal(t, m.String(), "[[28 1] [5 0]]")
+
+	b := FromMod[int](&err, m)
+	assert.NilError(t, err)
+	r := New(&err, []int{28, 1}, []int{5, 0})
+	assert.NilError(t, err)
+	assert.Check(t, b.Equal(r))
+
+	c := New(&err, []float64{1.5})
+	assert.NilError(t, err)
+	_ = c.Mod(&err, 29)
+	assert.ErrorContains(t, err, "cannot reduce a non-integer value modulo p")
+	err = nil
+
+	for _, v := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		f := New(&err, []float64{1, v})
+		assert.NilError(t, err)
+		_ = f.Mod(&err, 7)
+		assert.ErrorContains(t, err, "cannot reduce a non-integer value modulo p")
+		err = nil
+	}
+
+	d := NewMod(&err, 257, []int64{256})
+	assert.NilError(t, err)
+	_ = FromMod[int8](&err, d)
+	assert.ErrorContains(t, err, "result overflows the matrix element type")
+}
+
+func TestModArithmetic(t *testing.T) {
+	var err error
+
+	a := NewMod(&err, 5, []int64{1, 2}, []int64{3, 4})
+	assert.NilError(t, err)
+	b := NewMod(&err, 5, []int64{4, 4}, []int64{1, 2})
+	assert.NilError(t, err)
+
+	m := a.Add(&err, b)
+	assert.NilError(t, err)
+	assert.Equal(t, m.String(), "[[0 1] [4 1]]")
+
+	m = a.Subtract(&err, b)
+	assert.NilError(t, err)
+	assert.Equal(t, m.String(), "[[2 3] [2 2]]")
+
+	m = a.Multiply(&err, b)
+	assert.NilError(t, err)
+	assert.Equal(t, m.String(), "[[1 3] [1 0]]")
+
+	m = a.MultiplyScalar(&err, -1)
+	assert.NilError(t, err)
+	assert.Equal(t, m.String(), "[[4 3] [2 1]]")
+
+	m = a.Transpose(&err)
+	assert.NilError(t, err)
+	assert.Equal(t, m.String(), "[[1 3] [2 4]]")
+
+	// Large prime near 2^64
+	p := uint64(18446744073709551557)
+	c := NewMod(&err, p, []int64{-1})
+	assert.NilError(t, err)
+	m = c.Multiply(&err, c)
+	assert.NilError(t, err)
+	assert.Equal(t, m.Values[0][0], uint64(1))
+	m = c.Add(&err, c)
+	assert.NilError(t, err)
+	assert.Equal(t, m.Values[0][0], p-2)
+
+	d := NewMod(&err, 7, []int64{1, 2}, []int64{3, 4})
+	assert.NilError(t, err)
+	_ = a.Add(&err, d)
+	assert.ErrorContains(t, err, "cannot combine matrices with different moduli")
+	err = nil
+
+	e := NewMod(&err, 5, []int64{1, 2, 3})
+	assert.NilError(t, err)
+	_ = e.Multiply(&err, a)
+	assert.ErrorContains(t, err, "cannot multiply matrices due to incompatible dimensions")
+}
+
+func TestModInverse(t *testing.T) {
+	var err error
+
+	// Hill cipher key over GF(29)
+	a := NewMod(&err, 29, []int64{3, 3}, []int64{2, 5})
+	assert.NilError(t, err)
+	inv := a.Inverse(&err)
+	assert.NilError(t, err)
+	m := a.Multiply(&err, inv)
+	assert.NilError(t, err)
+	i := NewMod(&err, 29, []int64{1, 0}, []int64{0, 1})
+	assert.NilError(t, err)
+	assert.Check(t, m.Equal(i))
+
+	// Decrypt a message
+	plain := NewMod(&err, 29, []int64{7, 4}, []int64{11, 11})
+	assert.NilError(t, err)
+	cipher := a.Multiply(&err, plain)
+	assert.NilError(t, err)
+	m = inv.Multiply(&err, cipher)
+	assert.NilError(t, err)
+	assert.Check(t, m.Equal(plain))
+
+	// Singular modulo p but not over the integers
+	a = NewMod(&err, 5, []int64{1, 2}, []int64{3, 1})
+	assert.NilError(t, err)
+	_ = a.Inverse(&err)
+	assert.ErrorContains(t, err, "cannot invert, matrix is singular")
+}
+
+func TestModSolve(t *testing.T) {
+	var err error
+
+	a := NewMod(&err, 11, []int64{2, 1, 1}, []int64{1, 3, 2}, []int64{1, 0, 0})
+	assert.NilError(t, err)
+	b := NewMod(&err, 11, []int64{4}, []int64{5}, []int64{6})
+	assert.NilError(t, err)
+	x := a.Solve(&err, b)
+	assert.NilError(t, err)
+	m := a.Multiply(&err, x)
+	assert.NilError(t, err)
+	assert.Check(t, m.Equal(b))
+
+	c := NewMod(&err, 11, []int64{1}, []int64{2})
+	assert.NilError(t, err)
+	_ = a.Solve(&err, c)
+	assert.ErrorContains(t, err, "cannot solve due to incompatible dimensions")
+}
+
+func TestModDeterminant(t *testing.T) {
+	var err error
+
+	a := NewMod(&err, 7, []int64{0, 2, 1}, []int64{1, 0, 3}, []int64{4, 1, 0})
+	assert.NilError(t, err)
+	// The integer determinant is 25.
+	assert.Equal(t, a.Determinant(&err), uint64(4))
+	assert.NilError(t, err)
+
+	a = NewMod(&err, 5, []int64{1, 2}, []int64{3, 1})
+	assert.NilError(t, err)
+	assert.Equal(t, a.Determinant(&err), uint64(0))
+	assert.NilError(t, err)
+}
+
+func TestModRREF(t *testing.T) {
+	var err error
+
+	a := NewMod(&err, 5, []int64{2, 4, 2}, []int64{1, 2, 3})
+	assert.NilError(t, err)
+	m := a.RREF(&err)
+	assert.NilError(t, err)
+	assert.Equal(t, m.String(), "[[1 2 0] [0 0 1]]")
+	assert.Equal(t, a.Rank(&err), 2)
+	assert.NilError(t, err)
+
+	a = NewMod(&err, 5, []int64{1, 2}, []int64{3, 1})
+	assert.NilError(t, err)
+	assert.Equal(t, a.Rank(&err), 1)
+	assert.NilError(t, err)
+}
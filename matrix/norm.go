@@ -0,0 +1,258 @@
+package matrix
+
+import (
+	"errors"
+	"math"
+)
+
+// NormType selects a matrix norm.
+type NormType int
+
+const (
+	// FrobeniusNorm is the square root of the sum of the squared elements.
+	FrobeniusNorm NormType = iota
+	// OneNorm is the largest absolute column sum.
+	OneNorm
+	// InfNorm is the largest absolute row sum.
+	InfNorm
+	// MaxNorm is the largest absolute element.
+	MaxNorm
+	// SpectralNorm is the largest singular value.
+	SpectralNorm
+	// NuclearNorm is the sum of the singular values.
+	NuclearNorm
+)
+
+// Norm calculates the given norm of a matrix.
+// https://en.wikipedia.org/wiki/Matrix_norm
+func (a Matrix[T]) Norm(err *error, norm NormType) float64 {
+	// Avoid hiding previous errors.
+	if *err != nil {
+		return 0
+	}
+
+	values := a.float64Values()
+	switch norm {
+	case FrobeniusNorm:
+		sum := 0.0
+		for _, row := range values {
+			for _, v := range row {
+				sum = math.Hypot(sum, v)
+			}
+		}
+		return sum
+	case OneNorm:
+		return oneNorm(values)
+	case InfNorm:
+		max := 0.0
+		for _, row := range values {
+			sum := 0.0
+			for _, v := range row {
+				sum += math.Abs(v)
+			}
+			max = math.Max(max, sum)
+		}
+		return max
+	case MaxNorm:
+		return maxAbs(values)
+	case SpectralNorm:
+		_, s, _, _ := svd(values, false)
+		return s[0]
+	case NuclearNorm:
+		_, s, _, _ := svd(values, false)
+		sum := 0.0
+		for _, v := range s {
+			sum += v
+		}
+		return sum
+	default:
+		*err = errors.New("unknown norm type")
+		return 0
+	}
+}
+
+// ConditionNumber calculates the condition number of a matrix in the given
+// norm, ||A|| * ||inverse(A)||. The spectral condition number is the ratio of
+// the largest and smallest singular values and is defined for any shape, while
+// the other norms require a square matrix. Singular matrices have an infinite
+// condition number.
+// https://en.wikipedia.org/wiki/Condition_number#Matrices
+func (a Matrix[T]) ConditionNumber(err *error, norm NormType) float64 {
+	// Avoid hiding previous errors.
+	if *err != nil {
+		return 0
+	}
+
+	if norm == SpectralNorm {
+		_, s, _, _ := svd(a.float64Values(), false)
+		if s[len(s)-1] == 0 {
+			return math.Inf(1)
+		}
+		return s[0] / s[len(s)-1]
+	}
+
+	if a.Dimensions.Height != a.Dimensions.Width {
+		*err = errors.New("cannot calculate the condition number of a non-square matrix")
+		return 0
+	}
+
+	n := a.Norm(err, norm)
+	if *err != nil {
+		return 0
+	}
+	// Only an exactly zero pivot makes the matrix singular, since nearly
+	// singular matrices have large but finite condition numbers.
+	f := a.LUWithTolerance(err, 0)
+	if f.singular() {
+		return math.Inf(1)
+	}
+	inv := fromFloat64[float64](f.solve(identity(len(f.lu))))
+	return n * inv.Norm(err, norm)
+}
+
+// ConditionEstimate estimates the 1-norm condition number of a square matrix
+// using Hager's method with Higham's refinements, which only needs an LU
+// factorization and a few triangular solves instead of the full inverse.
+// The estimate is a lower bound that is usually within a factor of 3 of the
+// true value. Singular matrices have an infinite condition number.
+// https://doi.org/10.1145/50063.214386
+func (a Matrix[T]) ConditionEstimate(err *error) float64 {
+	// Avoid hiding previous errors.
+	if *err != nil {
+		return 0
+	}
+
+	// Check the matrix is square.
+	if a.Dimensions.Height != a.Dimensions.Width {
+		*err = errors.New("cannot calculate the condition number of a non-square matrix")
+		return 0
+	}
+
+	f := a.LUWithTolerance(err, 0)
+	if f.singular() {
+		return math.Inf(1)
+	}
+	return oneNorm(a.float64Values()) * f.inverseOneNormEstimate()
+}
+
+// inverseOneNormEstimate estimates the 1-norm of the inverse of the factorized
+// matrix.
+func (f LU[T]) inverseOneNormEstimate() float64 {
+	n := len(f.lu)
+	x := make([]float64, n)
+	for i := range x {
+		x[i] = 1 / float64(n)
+	}
+
+	// Search for the column of the inverse with the largest 1-norm by
+	// following the subgradient of ||inverse(A) * x||.
+	est := 0.0
+	last := -1
+	for iter := 0; iter < 5; iter++ {
+		y := f.solveVector(x)
+		est = vectorOneNorm(y)
+
+		xi := make([]float64, n)
+		for i, v := range y {
+			xi[i] = math.Copysign(1, v)
+		}
+		z := f.solveTransposeVector(xi)
+
+		j := 0
+		dot := 0.0
+		for i, v := range z {
+			if math.Abs(v) > math.Abs(z[j]) {
+				j = i
+			}
+			dot += v * x[i]
+		}
+		if iter > 0 && (math.Abs(z[j]) <= dot || j == last) {
+			break
+		}
+		last = j
+		for i := range x {
+			x[i] = 0
+		}
+		x[j] = 1
+	}
+
+	// Guard against poor estimates with an alternating test vector.
+	if n > 1 {
+		b := make([]float64, n)
+		for i := range b {
+			b[i] = 1 + float64(i)/float64(n-1)
+			if i%2 == 1 {
+				b[i] = -b[i]
+			}
+		}
+		alt := 2 * vectorOneNorm(f.solveVector(b)) / float64(3*n)
+		est = math.Max(est, alt)
+	}
+	return est
+}
+
+// solveVector solves A * x = b for a single vector b.
+func (f LU[T]) solveVector(b []float64) []float64 {
+	col := make([][]float64, len(b))
+	for j := range b {
+		col[j] = []float64{b[j]}
+	}
+	x := f.solve(col)
+	v := make([]float64, len(b))
+	for j := range x {
+		v[j] = x[j][0]
+	}
+	return v
+}
+
+// solveTransposeVector solves A' * x = b for a single vector b.
+// Since P * A = L * U, A' = U' * L' * P.
+func (f LU[T]) solveTransposeVector(b []float64) []float64 {
+	n := len(f.lu)
+	w := make([]float64, n)
+	copy(w, b)
+
+	// Solve U' * W = B.
+	for j := 0; j < n; j++ {
+		for k := 0; k < j; k++ {
+			w[j] -= f.lu[k][j] * w[k]
+		}
+		w[j] /= f.lu[j][j]
+	}
+
+	// Solve L' * V = W.
+	for j := n - 1; j >= 0; j-- {
+		for k := j + 1; k < n; k++ {
+			w[j] -= f.lu[k][j] * w[k]
+		}
+	}
+
+	// X = P' * V
+	x := make([]float64, n)
+	for j := 0; j < n; j++ {
+		x[f.pivot[j]] = w[j]
+	}
+	return x
+}
+
+// oneNorm calculates the largest absolute column sum of values.
+func oneNorm(values [][]float64) float64 {
+	max := 0.0
+	for i := range values[0] {
+		sum := 0.0
+		for j := range values {
+			sum += math.Abs(values[j][i])
+		}
+		max = math.Max(max, sum)
+	}
+	return max
+}
+
+// vectorOneNorm calculates the sum of the absolute values of v.
+func vectorOneNorm(v []float64) float64 {
+	sum := 0.0
+	for _, x := range v {
+		sum += math.Abs(x)
+	}
+	return sum
+}
@@ -0,0 +1,122 @@
+package matrix
+
+import (
+	"math"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestNorm(t *testing.T) {
+	var err error
+
+	a := New(&err, []float64{1, -2}, []float64{3, 4})
+	assert.NilError(t, err)
+
+	assert.Check(t, math.Abs(a.Norm(&err, FrobeniusNorm)-math.Sqrt(30)) < 0.0001)
+	assert.Equal(t, a.Norm(&err, OneNorm), 6.0)
+	assert.Equal(t, a.Norm(&err, InfNorm), 7.0)
+	assert.Equal(t, a.Norm(&err, MaxNorm), 4.0)
+	assert.Check(t, math.Abs(a.Norm(&err, SpectralNorm)-math.Sqrt(15+math.Sqrt(125))) < 0.0001)
+	assert.Check(t, math.Abs(a.Norm(&err, NuclearNorm)-math.Sqrt(50)) < 0.0001)
+	assert.NilError(t, err)
+
+	// Non-square integer
+	b := New(&err, []int{1, 2, 3}, []int{-4, 5, -6})
+	assert.NilError(t, err)
+	assert.Equal(t, b.Norm(&err, OneNorm), 9.0)
+	assert.Equal(t, b.Norm(&err, InfNorm), 15.0)
+	assert.Equal(t, b.Norm(&err, MaxNorm), 6.0)
+	assert.Check(t, math.Abs(b.Norm(&err, FrobeniusNorm)-math.Sqrt(91)) < 0.0001)
+	assert.NilError(t, err)
+
+	_ = a.Norm(&err, NormType(-1))
+	assert.ErrorContains(t, err, "unknown norm type")
+}
+
+func TestConditionNumber(t *testing.T) {
+	var err error
+
+	a := New(&err, []float64{1, -2}, []float64{3, 4})
+	assert.NilError(t, err)
+	assert.Check(t, math.Abs(a.ConditionNumber(&err, OneNorm)-4.2) < 0.0001)
+	assert.NilError(t, err)
+	s := math.Sqrt(15 + math.Sqrt(125))
+	assert.Check(t, math.Abs(a.ConditionNumber(&err, SpectralNorm)-s*s/10) < 0.0001)
+	assert.NilError(t, err)
+
+	// Identity
+	i := NewIdentity[float64](&err, Dimension{Width: 3, Height: 3})
+	assert.NilError(t, err)
+	assert.Check(t, math.Abs(i.ConditionNumber(&err, FrobeniusNorm)-3) < 0.0001)
+	assert.NilError(t, err)
+
+	// Singular
+	b := New(&err, []float64{1, 2}, []float64{2, 4})
+	assert.NilError(t, err)
+	assert.Check(t, math.IsInf(b.ConditionNumber(&err, OneNorm), 1))
+	assert.Check(t, math.IsInf(b.ConditionNumber(&err, SpectralNorm), 1))
+	assert.NilError(t, err)
+
+	// Nearly singular
+	d := New(&err, []float64{1e13, 0}, []float64{0, 1})
+	assert.NilError(t, err)
+	assert.Equal(t, d.ConditionNumber(&err, OneNorm), 1e13)
+	assert.Equal(t, d.ConditionNumber(&err, SpectralNorm), 1e13)
+	assert.Equal(t, d.ConditionEstimate(&err), 1e13)
+	assert.NilError(t, err)
+
+	// Non-square
+	c := New(&err, []float64{1, 0}, []float64{0, 2}, []float64{0, 0})
+	assert.NilError(t, err)
+	assert.Check(t, math.Abs(c.ConditionNumber(&err, SpectralNorm)-2) < 0.0001)
+	assert.NilError(t, err)
+	_ = c.ConditionNumber(&err, OneNorm)
+	assert.ErrorContains(t, err, "cannot calculate the condition number of a non-square matrix")
+}
+
+func TestConditionEstimate(t *testing.T) {
+	var err error
+
+	a := New(&err, []float64{1, -2}, []float64{3, 4})
+	assert.NilError(t, err)
+	assert.Check(t, math.Abs(a.ConditionEstimate(&err)-4.2) < 0.0001)
+	assert.NilError(t, err)
+
+	// 6x6 Hilbert matrix
+	n := 6
+	values := make([][]float64, n)
+	for j := 0; j < n; j++ {
+		values[j] = make([]float64, n)
+		for i := 0; i < n; i++ {
+			values[j][i] = 1.0 / float64(i+j+1)
+		}
+	}
+	h := New(&err, values...)
+	assert.NilError(t, err)
+	exact := h.ConditionNumber(&err, OneNorm)
+	assert.NilError(t, err)
+	est := h.ConditionEstimate(&err)
+	assert.NilError(t, err)
+	assert.Check(t, est <= exact*1.0001 && est >= exact/3)
+
+	// Permuted matrix
+	p := New(&err, []float64{0, 2, 1}, []float64{1, 0, 3}, []float64{4, 1, 0})
+	assert.NilError(t, err)
+	exact = p.ConditionNumber(&err, OneNorm)
+	assert.NilError(t, err)
+	est = p.ConditionEstimate(&err)
+	assert.NilError(t, err)
+	assert.Check(t, est <= exact*1.0001 && est >= exact/3)
+
+	// Singular
+	b := New(&err, []float64{1, 2}, []float64{2, 4})
+	assert.NilError(t, err)
+	assert.Check(t, math.IsInf(b.ConditionEstimate(&err), 1))
+	assert.NilError(t, err)
+
+	c := New(&err, []float64{1, 2})
+	assert.NilError(t, err)
+	_ = c.ConditionEstimate(&err)
+	assert.ErrorContains(t, err, "cannot calculate the condition number of a non-square matrix")
+}
@@ -0,0 +1,73 @@
+package matrix
+
+import (
+	"errors"
+	"math"
+)
+
+// PseudoInverse calculates the Moore-Penrose pseudo-inverse of a matrix of any
+// shape using its singular value decomposition. Singular values not greater
+// than max(m, n) * machine epsilon relative to the largest singular value are
+// treated as zero.
+// https://en.wikipedia.org/wiki/Moore%E2%80%93Penrose_inverse
+func (a Matrix[T]) PseudoInverse(err *error) Matrix[T] {
+	size := a.Dimensions.Width
+	if a.Dimensions.Height > size {
+		size = a.Dimensions.Height
+	}
+	return a.PseudoInverseWithTolerance(err, float64(size)*math.Pow(2, -52))
+}
+
+// PseudoInverseWithTolerance calculates the Moore-Penrose pseudo-inverse of a
+// matrix, treating singular values not greater than tolerance relative to the
+// largest singular value as zero.
+func (a Matrix[T]) PseudoInverseWithTolerance(err *error, tolerance float64) Matrix[T] {
+	// Avoid hiding previous errors.
+	if *err != nil {
+		return Matrix[T]{}
+	}
+
+	if tolerance < 0 {
+		*err = errors.New("singular value tolerance cannot be negative")
+		return Matrix[T]{}
+	}
+
+	if !a.isFinite() {
+		*err = errors.New("cannot calculate the pseudo-inverse of a matrix with non-finite values")
+		return Matrix[T]{}
+	}
+
+	p, ok := pseudoInverse(a.float64Values(), tolerance)
+	if !ok {
+		*err = errors.New("cannot calculate the pseudo-inverse, singular value decomposition did not converge")
+		return Matrix[T]{}
+	}
+	return fromFloat64[T](p)
+}
+
+// pseudoInverse calculates V * inverse(S) * U' from the thin singular value
+// decomposition of a, ignoring singular values below the relative tolerance.
+// It also reports whether the singular value decomposition converged.
+func pseudoInverse(a [][]float64, tolerance float64) ([][]float64, bool) {
+	u, s, v, ok := svd(a, false)
+	m := len(u)
+	n := len(v)
+
+	limit := tolerance * s[0]
+	p := make([][]float64, n)
+	for j := 0; j < n; j++ {
+		p[j] = make([]float64, m)
+	}
+	for k := range s {
+		if s[k] == 0 || s[k] <= limit {
+			continue
+		}
+		for j := 0; j < n; j++ {
+			f := v[j][k] / s[k]
+			for i := 0; i < m; i++ {
+				p[j][i] += f * u[i][k]
+			}
+		}
+	}
+	return p, ok
+}
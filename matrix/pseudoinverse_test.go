@@ -0,0 +1,114 @@
+package matrix
+
+import (
+	"math"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func checkPseudoInverse(t *testing.T, a, p Matrix[float64]) {
+	t.Helper()
+	var err error
+
+	assert.Equal(t, p.Dimensions, Dimension{Width: a.Dimensions.Height, Height: a.Dimensions.Width})
+
+	// A * A+ * A = A
+	m := a.Multiply(&err, p).Multiply(&err, a)
+	assert.NilError(t, err)
+	assert.Check(t, m.ApproxEqual(a, 0.0001))
+
+	// A+ * A * A+ = A+
+	m = p.Multiply(&err, a).Multiply(&err, p)
+	assert.NilError(t, err)
+	assert.Check(t, m.ApproxEqual(p, 0.0001))
+
+	// A * A+ and A+ * A are symmetric
+	m = a.Multiply(&err, p)
+	assert.NilError(t, err)
+	assert.Check(t, m.ApproxEqual(m.Transpose(&err), 0.0001))
+	m = p.Multiply(&err, a)
+	assert.NilError(t, err)
+	assert.Check(t, m.ApproxEqual(m.Transpose(&err), 0.0001))
+}
+
+func TestPseudoInverse(t *testing.T) {
+	var err error
+
+	// Square and invertible matches Inverse
+	a := New(&err, []float64{1, 2}, []float64{3, -5})
+	assert.NilError(t, err)
+	p := a.PseudoInverse(&err)
+	assert.NilError(t, err)
+	checkPseudoInverse(t, a, p)
+	inv := a.Inverse(&err)
+	assert.NilError(t, err)
+	assert.Check(t, p.ApproxEqual(inv, 0.0001))
+
+	// 3x2
+	a = New(&err, []float64{1, 2}, []float64{3, 4}, []float64{5, 6})
+	assert.NilError(t, err)
+	p = a.PseudoInverse(&err)
+	assert.NilError(t, err)
+	checkPseudoInverse(t, a, p)
+	r := New(&err,
+		[]float64{-4.0 / 3.0, -1.0 / 3.0, 2.0 / 3.0},
+		[]float64{13.0 / 12.0, 1.0 / 3.0, -5.0 / 12.0},
+	)
+	assert.NilError(t, err)
+	assert.Check(t, p.ApproxEqual(r, 0.0001))
+
+	// 2x3
+	a = New(&err, []float64{1, 0, 1}, []float64{0, 1, 1})
+	assert.NilError(t, err)
+	p = a.PseudoInverse(&err)
+	assert.NilError(t, err)
+	checkPseudoInverse(t, a, p)
+
+	// Rank deficient
+	a = New(&err, []float64{1, 2}, []float64{2, 4})
+	assert.NilError(t, err)
+	p = a.PseudoInverse(&err)
+	assert.NilError(t, err)
+	checkPseudoInverse(t, a, p)
+	r = New(&err, []float64{0.04, 0.08}, []float64{0.08, 0.16})
+	assert.NilError(t, err)
+	assert.Check(t, p.ApproxEqual(r, 0.0001))
+
+	// Zero
+	a = NewZero[float64](&err, Dimension{Width: 3, Height: 2})
+	assert.NilError(t, err)
+	p = a.PseudoInverse(&err)
+	assert.NilError(t, err)
+	z := NewZero[float64](&err, Dimension{Width: 2, Height: 3})
+	assert.NilError(t, err)
+	assert.Check(t, p.Equal(z))
+}
+
+func TestPseudoInverseWithTolerance(t *testing.T) {
+	var err error
+
+	// A tiny singular value is dropped with a larger cutoff.
+	a := New(&err, []float64{1, 0}, []float64{0, 1e-8})
+	assert.NilError(t, err)
+	p := a.PseudoInverse(&err)
+	assert.NilError(t, err)
+	r := New(&err, []float64{1, 0}, []float64{0, 1e8})
+	assert.NilError(t, err)
+	assert.Check(t, p.ApproxEqual(r, 0.0001))
+
+	p = a.PseudoInverseWithTolerance(&err, 1e-6)
+	assert.NilError(t, err)
+	r = New(&err, []float64{1, 0}, []float64{0, 0})
+	assert.NilError(t, err)
+	assert.Check(t, p.ApproxEqual(r, 0.0001))
+
+	_ = a.PseudoInverseWithTolerance(&err, -1)
+	assert.ErrorContains(t, err, "singular value tolerance cannot be negative")
+	err = nil
+
+	a = New(&err, []float64{1, math.NaN()}, []float64{0, 1})
+	assert.NilError(t, err)
+	_ = a.PseudoInverse(&err)
+	assert.ErrorContains(t, err, "cannot calculate the pseudo-inverse of a matrix with non-finite values")
+}
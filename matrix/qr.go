@@ -0,0 +1,153 @@
+package matrix
+
+import (
+	"errors"
+	"math"
+
+	"golang.org/x/exp/constraints"
+)
+
+// QRMethod selects the algorithm used to calculate a QR factorization.
+type QRMethod int
+
+const (
+	// Householder calculates the factorization using Householder reflections.
+	Householder QRMethod = iota
+	// Givens calculates the factorization using Givens rotations.
+	Givens
+)
+
+// QR is the QR factorization of an m×n matrix, such that A = Q * R.
+// The factors are floating point for every element type.
+type QR[T constraints.Integer | constraints.Float] struct {
+	// Q is an m×m orthogonal matrix.
+	Q Matrix[float64]
+	// R is an m×n upper triangular matrix.
+	R Matrix[float64]
+}
+
+// QR factorizes a matrix of any shape into an orthogonal and an upper
+// triangular matrix using the given method.
+func (a Matrix[T]) QR(err *error, method QRMethod) QR[T] {
+	// Avoid hiding previous errors.
+	if *err != nil {
+		return QR[T]{}
+	}
+
+	var q, r [][]float64
+	switch method {
+	case Householder:
+		q, r = householderQR(a.float64Values())
+	case Givens:
+		q, r = givensQR(a.float64Values())
+	default:
+		*err = errors.New("unknown QR method")
+		return QR[T]{}
+	}
+
+	return QR[T]{
+		Q: fromFloat64[float64](q),
+		R: fromFloat64[float64](r),
+	}
+}
+
+// householderQR reduces r to upper triangular form with Householder
+// reflections, returning the accumulated orthogonal matrix and r.
+// https://en.wikipedia.org/wiki/QR_decomposition#Using_Householder_reflections
+func householderQR(r [][]float64) ([][]float64, [][]float64) {
+	m := len(r)
+	n := len(r[0])
+	q := identity(m)
+
+	v := make([]float64, m)
+	for k := 0; k < n && k < m-1; k++ {
+		// Build the reflector that zeroes column k below the diagonal.
+		norm := 0.0
+		for j := k; j < m; j++ {
+			norm = math.Hypot(norm, r[j][k])
+		}
+		if norm == 0 {
+			continue
+		}
+		alpha := -math.Copysign(norm, r[k][k])
+		vnorm := 0.0
+		for j := k; j < m; j++ {
+			v[j] = r[j][k]
+			if j == k {
+				v[j] -= alpha
+			}
+			vnorm = math.Hypot(vnorm, v[j])
+		}
+		if vnorm == 0 {
+			continue
+		}
+		for j := k; j < m; j++ {
+			v[j] /= vnorm
+		}
+
+		// R = H * R
+		for i := k; i < n; i++ {
+			dot := 0.0
+			for j := k; j < m; j++ {
+				dot += v[j] * r[j][i]
+			}
+			for j := k; j < m; j++ {
+				r[j][i] -= 2 * dot * v[j]
+			}
+		}
+
+		// Q = Q * H
+		for j := 0; j < m; j++ {
+			dot := 0.0
+			for i := k; i < m; i++ {
+				dot += q[j][i] * v[i]
+			}
+			for i := k; i < m; i++ {
+				q[j][i] -= 2 * dot * v[i]
+			}
+		}
+
+		// Remove rounding noise below the diagonal.
+		r[k][k] = alpha
+		for j := k + 1; j < m; j++ {
+			r[j][k] = 0
+		}
+	}
+
+	return q, r
+}
+
+// givensQR reduces r to upper triangular form with Givens rotations,
+// returning the accumulated orthogonal matrix and r.
+// https://en.wikipedia.org/wiki/Givens_rotation#Triangularization
+func givensQR(r [][]float64) ([][]float64, [][]float64) {
+	m := len(r)
+	n := len(r[0])
+	q := identity(m)
+
+	for k := 0; k < n; k++ {
+		for j := m - 1; j > k; j-- {
+			if r[j][k] == 0 {
+				continue
+			}
+
+			// Rotate rows j-1 and j to zero r[j][k].
+			h := math.Hypot(r[j-1][k], r[j][k])
+			c := r[j-1][k] / h
+			s := r[j][k] / h
+			for i := k; i < n; i++ {
+				x, y := r[j-1][i], r[j][i]
+				r[j-1][i] = c*x + s*y
+				r[j][i] = -s*x + c*y
+			}
+			for i := 0; i < m; i++ {
+				x, y := q[i][j-1], q[i][j]
+				q[i][j-1] = c*x + s*y
+				q[i][j] = -s*x + c*y
+			}
+			r[j][k] = 0
+		}
+	}
+
+	return q, r
+}
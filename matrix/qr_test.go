@@ -0,0 +1,90 @@
+package matrix
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func checkQR(t *testing.T, a Matrix[float64], method QRMethod) {
+	t.Helper()
+	var err error
+
+	f := a.QR(&err, method)
+	assert.NilError(t, err)
+	assert.Equal(t, f.Q.Dimensions, Dimension{Width: a.Dimensions.Height, Height: a.Dimensions.Height})
+	assert.Equal(t, f.R.Dimensions, a.Dimensions)
+
+	// A = Q * R
+	qr := f.Q.Multiply(&err, f.R)
+	assert.NilError(t, err)
+	assert.Check(t, qr.ApproxEqual(a, 0.0001))
+
+	// Q' * Q = I
+	qq := f.Q.Transpose(&err).Multiply(&err, f.Q)
+	assert.NilError(t, err)
+	i := NewIdentity[float64](&err, qq.Dimensions)
+	assert.NilError(t, err)
+	assert.Check(t, qq.ApproxEqual(i, 0.0001))
+
+	// R is upper triangular
+	for j := 0; j < f.R.Dimensions.Height; j++ {
+		for i := 0; i < j && i < f.R.Dimensions.Width; i++ {
+			assert.Equal(t, f.R.Values[j][i], 0.0)
+		}
+	}
+}
+
+func TestQR(t *testing.T) {
+	var err error
+
+	// 3x3
+	a := New(&err, []float64{12, -51, 4}, []float64{6, 167, -68}, []float64{-4, 24, -41})
+	assert.NilError(t, err)
+	checkQR(t, a, Householder)
+	checkQR(t, a, Givens)
+
+	// 4x2
+	a = New(&err, []float64{1, 2}, []float64{3, 4}, []float64{5, 6}, []float64{7, 8})
+	assert.NilError(t, err)
+	checkQR(t, a, Householder)
+	checkQR(t, a, Givens)
+
+	// 2x4
+	a = New(&err, []float64{1, 2, 3, 4}, []float64{5, 6, 7, 8})
+	assert.NilError(t, err)
+	checkQR(t, a, Householder)
+	checkQR(t, a, Givens)
+
+	// Integer matrices have floating point factors.
+	b := New(&err, []int{2, 1}, []int{4, 3})
+	assert.NilError(t, err)
+	for _, method := range []QRMethod{Householder, Givens} {
+		f := b.QR(&err, method)
+		assert.NilError(t, err)
+		qr := f.Q.Multiply(&err, f.R)
+		assert.NilError(t, err)
+		assert.Check(t, qr.ApproxEqual(New(&err, []float64{2, 1}, []float64{4, 3}), 1e-12))
+	}
+
+	// Rank deficient
+	a = New(&err, []float64{1, 2, 3}, []float64{2, 4, 6}, []float64{0, 0, 1})
+	assert.NilError(t, err)
+	checkQR(t, a, Householder)
+	checkQR(t, a, Givens)
+
+	// 1x1
+	a = New(&err, []float64{-3})
+	assert.NilError(t, err)
+	checkQR(t, a, Householder)
+	checkQR(t, a, Givens)
+}
+
+func TestQRErrors(t *testing.T) {
+	var err error
+
+	a := New(&err, []float64{1, 2}, []float64{3, 4})
+	assert.NilError(t, err)
+	_ = a.QR(&err, QRMethod(-1))
+	assert.ErrorContains(t, err, "unknown QR method")
+}
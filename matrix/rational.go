@@ -0,0 +1,420 @@
+package matrix
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"golang.org/x/exp/constraints"
+)
+
+// RationalMatrix is a matrix of exact rational numbers.
+// Operations never round, so integer matrices can be inverted, solved and
+// reduced exactly.
+type RationalMatrix struct {
+	Dimensions Dimension
+	Values     [][]*big.Rat
+}
+
+// NewRational instantiates a RationalMatrix with the passed values.
+// The values are copied.
+func NewRational(err *error, values ...[]*big.Rat) RationalMatrix {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return RationalMatrix{}
+	}
+
+	height := len(values)
+	if height < 1 {
+		*err = errors.New("cannot create a Matrix with a dimension that is less than 1")
+		return RationalMatrix{}
+	}
+
+	width := len(values[0])
+	if width < 1 {
+		*err = errors.New("cannot create a Matrix with a dimension that is less than 1")
+		return RationalMatrix{}
+	}
+
+	for _, row := range values {
+		if len(row) != width {
+			*err = errors.New("cannot create a Matrix with different row lengths")
+			return RationalMatrix{}
+		}
+	}
+
+	return RationalMatrix{
+		Dimensions: Dimension{
+			Width:  width,
+			Height: height,
+		},
+		Values: values,
+	}.Clone()
+}
+
+// Rational converts a Matrix to a RationalMatrix.
+// Floating point values are converted exactly, and must be finite.
+func (a Matrix[T]) Rational(err *error) RationalMatrix {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return RationalMatrix{}
+	}
+
+	if !a.isFinite() {
+		*err = errors.New("cannot convert a non-finite value to a rational matrix")
+		return RationalMatrix{}
+	}
+
+	return RationalMatrix{
+		Dimensions: a.Dimensions,
+		Values:     a.ratValues(),
+	}
+}
+
+// FromRational converts a RationalMatrix to a Matrix.
+// Converting to an integer type reports an error if a value is not an integer
+// or does not fit in T. Converting to a floating point type rounds to the
+// nearest value.
+func FromRational[T constraints.Integer | constraints.Float](err *error, a RationalMatrix) Matrix[T] {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return Matrix[T]{}
+	}
+
+	if isInteger[T]() {
+		for _, row := range a.Values {
+			for _, v := range row {
+				if !v.IsInt() {
+					*err = errors.New("cannot convert a non-integer value to an integer matrix")
+					return Matrix[T]{}
+				}
+			}
+		}
+		return fromRat[T](err, a.Values)
+	}
+
+	values := make([][]float64, a.Dimensions.Height)
+	for j := range values {
+		values[j] = make([]float64, a.Dimensions.Width)
+		for i := range values[j] {
+			values[j][i], _ = a.Values[j][i].Float64()
+		}
+	}
+	return fromFloat64[T](values)
+}
+
+// MultiplyScalar multiplies the Matrix by a scalar.
+func (a RationalMatrix) MultiplyScalar(err *error, x *big.Rat) RationalMatrix {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return RationalMatrix{}
+	}
+
+	m := a.Clone()
+	for j := 0; j < a.Dimensions.Height; j++ {
+		for i := 0; i < a.Dimensions.Width; i++ {
+			m.Values[j][i].Mul(m.Values[j][i], x)
+		}
+	}
+	return m
+}
+
+// Multiply multiplies the matrix by another matrix.
+// The height of matix B must match the width of matrix A.
+func (a RationalMatrix) Multiply(err *error, b RationalMatrix) RationalMatrix {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return RationalMatrix{}
+	}
+
+	// Check matrices can be multiplied.
+	if a.Dimensions.Width != b.Dimensions.Height {
+		*err = errors.New("cannot multiply matrices due to incompatible dimensions")
+		return RationalMatrix{}
+	}
+
+	width := b.Dimensions.Width
+	height := a.Dimensions.Height
+	m := make([][]*big.Rat, height)
+	t := new(big.Rat)
+	for j := 0; j < height; j++ {
+		m[j] = make([]*big.Rat, width)
+		for x := 0; x < width; x++ {
+			sum := new(big.Rat)
+			for i := 0; i < a.Dimensions.Width; i++ {
+				sum.Add(sum, t.Mul(a.Values[j][i], b.Values[i][x]))
+			}
+			m[j][x] = sum
+		}
+	}
+
+	return RationalMatrix{
+		Dimensions: Dimension{
+			Width:  width,
+			Height: height,
+		},
+		Values: m,
+	}
+}
+
+// Add a matrix to another one.
+// The dimensions of the matrices must match.
+func (a RationalMatrix) Add(err *error, b RationalMatrix) RationalMatrix {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return RationalMatrix{}
+	}
+
+	// Check matrices can be added.
+	if a.Dimensions != b.Dimensions {
+		*err = errors.New("cannot add matrices due to incompatible dimensions")
+		return RationalMatrix{}
+	}
+
+	m := a.Clone()
+	for j := 0; j < a.Dimensions.Height; j++ {
+		for i := 0; i < a.Dimensions.Width; i++ {
+			m.Values[j][i].Add(m.Values[j][i], b.Values[j][i])
+		}
+	}
+	return m
+}
+
+// Subtract a matrix from another one.
+// The dimensions of the matrices must match.
+func (a RationalMatrix) Subtract(err *error, b RationalMatrix) RationalMatrix {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return RationalMatrix{}
+	}
+
+	// Check matrices can be subtracted.
+	if a.Dimensions != b.Dimensions {
+		*err = errors.New("cannot subtract matrices due to incompatible dimensions")
+		return RationalMatrix{}
+	}
+
+	m := a.Clone()
+	for j := 0; j < a.Dimensions.Height; j++ {
+		for i := 0; i < a.Dimensions.Width; i++ {
+			m.Values[j][i].Sub(m.Values[j][i], b.Values[j][i])
+		}
+	}
+	return m
+}
+
+// Inverse a matrix exactly using Gauss-Jordan elimination.
+func (a RationalMatrix) Inverse(err *error) RationalMatrix {
+	// Avoid hiding previous errors.
+	if *err != nil {
+		return RationalMatrix{}
+	}
+
+	// Check the matrix is square.
+	if a.Dimensions.Height != a.Dimensions.Width {
+		*err = errors.New("cannot calculate the inverse of a non-square matrix")
+		return RationalMatrix{}
+	}
+
+	n := a.Dimensions.Width
+	i := make([][]*big.Rat, n)
+	for j := 0; j < n; j++ {
+		i[j] = make([]*big.Rat, n)
+		for k := 0; k < n; k++ {
+			i[j][k] = new(big.Rat)
+		}
+		i[j][j].SetInt64(1)
+	}
+
+	m, ok := a.reduce(i)
+	if !ok {
+		*err = errors.New("cannot invert, matrix is singular")
+		return RationalMatrix{}
+	}
+	return m
+}
+
+// Solve solves A * X = B for X exactly. B may have any number of columns.
+func (a RationalMatrix) Solve(err *error, b RationalMatrix) RationalMatrix {
+	// Avoid hiding previous errors.
+	if *err != nil {
+		return RationalMatrix{}
+	}
+
+	// Check the system can be solved.
+	if a.Dimensions.Height != a.Dimensions.Width {
+		*err = errors.New("cannot solve a non-square system")
+		return RationalMatrix{}
+	}
+	if a.Dimensions.Height != b.Dimensions.Height {
+		*err = errors.New("cannot solve due to incompatible dimensions")
+		return RationalMatrix{}
+	}
+
+	m, ok := a.reduce(b.Clone().Values)
+	if !ok {
+		*err = errors.New("cannot solve, matrix is singular")
+		return RationalMatrix{}
+	}
+	return m
+}
+
+// Determinant calculates the determinant of a square matrix exactly.
+func (a RationalMatrix) Determinant(err *error) *big.Rat {
+	// Avoid hiding previous errors.
+	if *err != nil {
+		return new(big.Rat)
+	}
+
+	// Check the matrix is square.
+	if a.Dimensions.Height != a.Dimensions.Width {
+		*err = errors.New("cannot calculate the determinant of a non-square matrix")
+		return new(big.Rat)
+	}
+
+	n := a.Dimensions.Width
+	m := a.Clone().Values
+	det := big.NewRat(1, 1)
+	t := new(big.Rat)
+	for k := 0; k < n; k++ {
+		p := -1
+		for j := k; j < n; j++ {
+			if m[j][k].Sign() != 0 {
+				p = j
+				break
+			}
+		}
+		if p < 0 {
+			return new(big.Rat)
+		}
+		if p != k {
+			m[k], m[p] = m[p], m[k]
+			det.Neg(det)
+		}
+		det.Mul(det, m[k][k])
+
+		for j := k + 1; j < n; j++ {
+			if m[j][k].Sign() == 0 {
+				continue
+			}
+			f := new(big.Rat).Quo(m[j][k], m[k][k])
+			for i := k; i < n; i++ {
+				m[j][i].Sub(m[j][i], t.Mul(f, m[k][i]))
+			}
+		}
+	}
+	return det
+}
+
+// RREF calculates the reduced row echelon form of a matrix exactly.
+func (a RationalMatrix) RREF(err *error) RationalMatrix {
+	// Avoid hiding previous errors.
+	if *err != nil {
+		return RationalMatrix{}
+	}
+
+	m := a.Clone()
+	rrefRat(m.Values)
+	return m
+}
+
+// Rank calculates the number of linearly independent rows of a matrix.
+func (a RationalMatrix) Rank(err *error) int {
+	// Avoid hiding previous errors.
+	if *err != nil {
+		return 0
+	}
+
+	return len(rrefRat(a.Clone().Values))
+}
+
+// Transpose calculates the transpose of a Matrix.
+func (a RationalMatrix) Transpose(err *error) RationalMatrix {
+	values := make([][]*big.Rat, a.Dimensions.Width)
+	for j := 0; j < a.Dimensions.Width; j++ {
+		values[j] = make([]*big.Rat, a.Dimensions.Height)
+		for i := 0; i < a.Dimensions.Height; i++ {
+			values[j][i] = new(big.Rat).Set(a.Values[i][j])
+		}
+	}
+
+	return RationalMatrix{
+		Dimensions: Dimension{
+			Width:  a.Dimensions.Height,
+			Height: a.Dimensions.Width,
+		},
+		Values: values,
+	}
+}
+
+func (a RationalMatrix) Clone() RationalMatrix {
+	values := make([][]*big.Rat, a.Dimensions.Height)
+	for j := 0; j < a.Dimensions.Height; j++ {
+		values[j] = make([]*big.Rat, a.Dimensions.Width)
+		for i := 0; i < a.Dimensions.Width; i++ {
+			values[j][i] = new(big.Rat).Set(a.Values[j][i])
+		}
+	}
+
+	return RationalMatrix{
+		Dimensions: a.Dimensions,
+		Values:     values,
+	}
+}
+
+func (a RationalMatrix) Equal(b RationalMatrix) bool {
+	if a.Dimensions != b.Dimensions {
+		return false
+	}
+	for j := 0; j < a.Dimensions.Height; j++ {
+		for i := 0; i < a.Dimensions.Width; i++ {
+			if a.Values[j][i].Cmp(b.Values[j][i]) != 0 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (a RationalMatrix) String() string {
+	values := make([][]string, a.Dimensions.Height)
+	for j := range values {
+		values[j] = make([]string, a.Dimensions.Width)
+		for i := range values[j] {
+			values[j][i] = a.Values[j][i].RatString()
+		}
+	}
+	return fmt.Sprint(values)
+}
+
+// reduce reduces [A | B] to [I | X] and returns X, reporting false if A is
+// singular. The values of b are overwritten.
+func (a RationalMatrix) reduce(b [][]*big.Rat) (RationalMatrix, bool) {
+	n := a.Dimensions.Width
+	augmented := make([][]*big.Rat, n)
+	for j := 0; j < n; j++ {
+		augmented[j] = make([]*big.Rat, 0, n+len(b[j]))
+		for i := 0; i < n; i++ {
+			augmented[j] = append(augmented[j], new(big.Rat).Set(a.Values[j][i]))
+		}
+		augmented[j] = append(augmented[j], b[j]...)
+	}
+
+	pivots := rrefRat(augmented)
+	if len(pivots) < n || pivots[n-1] != n-1 {
+		return RationalMatrix{}, false
+	}
+
+	width := len(b[0])
+	values := make([][]*big.Rat, n)
+	for j := 0; j < n; j++ {
+		values[j] = augmented[j][n:]
+	}
+	return RationalMatrix{
+		Dimensions: Dimension{
+			Width:  width,
+			Height: n,
+		},
+		Values: values,
+	}, true
+}
@@ -0,0 +1,197 @@
+package matrix
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestNewRational(t *testing.T) {
+	var err error
+
+	v := []*big.Rat{big.NewRat(1, 2), big.NewRat(2, 3)}
+	a := NewRational(&err, v, []*big.Rat{big.NewRat(3, 1), big.NewRat(-1, 4)})
+	assert.NilError(t, err)
+	assert.Equal(t, a.Dimensions, Dimension{Width: 2, Height: 2})
+	assert.Equal(t, a.String(), "[[1/2 2/3] [3 -1/4]]")
+
+	// The values are copied.
+	v[0].SetInt64(5)
+	assert.Equal(t, a.Values[0][0].RatString(), "1/2")
+
+	_ = NewRational(&err)
+	assert.ErrorContains(t, err, "cannot create a Matrix with a dimension that is less than 1")
+	err = nil
+
+	_ = NewRational(&err, []*big.Rat{big.NewRat(1, 1)}, []*big.Rat{})
+	assert.ErrorContains(t, err, "cannot create a Matrix with different row lengths")
+}
+
+func TestRationalConversion(t *testing.T) {
+	var err error
+
+	a := New(&err, []int{1, 2}, []int{3, 4})
+	assert.NilError(t, err)
+	r := a.Rational(&err)
+	assert.Equal(t, r.String(), "[[1 2] [3 4]]")
+	b := FromRational[int](&err, r)
+	assert.NilError(t, err)
+	assert.Check(t, b.Equal(a))
+
+	c := New(&err, []float64{0.5, 0.25})
+	assert.NilError(t, err)
+	s := c.Rational(&err)
+	assert.Equal(t, s.String(), "[[1/2 1/4]]")
+	d := FromRational[float64](&err, s)
+	assert.NilError(t, err)
+	assert.Check(t, d.Equal(c))
+
+	_ = FromRational[int](&err, s)
+	assert.ErrorContains(t, err, "cannot convert a non-integer value to an integer matrix")
+	err = nil
+
+	e := New(&err, []int{1000})
+	assert.NilError(t, err)
+	_ = FromRational[int8](&err, e.Rational(&err))
+	assert.ErrorContains(t, err, "result overflows the matrix element type")
+	err = nil
+
+	for _, v := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		f := New(&err, []float64{1, v})
+		assert.NilError(t, err)
+		r := f.Rational(&err)
+		assert.ErrorContains(t, err, "cannot convert a non-finite value to a rational matrix")
+		assert.Check(t, r.Equal(RationalMatrix{}))
+		err = nil
+	}
+}
+
+func TestRationalArithmetic(t *testing.T) {
+	var err error
+
+	a := New(&err, []int{1, 2}, []int{3, 4}).Rational(&err)
+	assert.NilError(t, err)
+	b := New(&err, []int{5, 6}, []int{7, 8}).Rational(&err)
+	assert.NilError(t, err)
+
+	m := a.Add(&err, b)
+	assert.NilError(t, err)
+	assert.Equal(t, m.String(), "[[6 8] [10 12]]")
+
+	m = a.Subtract(&err, b)
+	assert.NilError(t, err)
+	assert.Equal(t, m.String(), "[[-4 -4] [-4 -4]]")
+
+	m = a.Multiply(&err, b)
+	assert.NilError(t, err)
+	assert.Equal(t, m.String(), "[[19 22] [43 50]]")
+
+	m = a.MultiplyScalar(&err, big.NewRat(1, 3))
+	assert.NilError(t, err)
+	assert.Equal(t, m.String(), "[[1/3 2/3] [1 4/3]]")
+	assert.Equal(t, a.String(), "[[1 2] [3 4]]")
+
+	m = a.Transpose(&err)
+	assert.NilError(t, err)
+	assert.Equal(t, m.String(), "[[1 3] [2 4]]")
+
+	c := New(&err, []int{1, 2, 3}).Rational(&err)
+	assert.NilError(t, err)
+	_ = c.Multiply(&err, a)
+	assert.ErrorContains(t, err, "cannot multiply matrices due to incompatible dimensions")
+	err = nil
+	_ = c.Add(&err, a)
+	assert.ErrorContains(t, err, "cannot add matrices due to incompatible dimensions")
+	err = nil
+	_ = c.Subtract(&err, a)
+	assert.ErrorContains(t, err, "cannot subtract matrices due to incompatible dimensions")
+}
+
+func TestRationalInverse(t *testing.T) {
+	var err error
+
+	// An integer matrix whose inverse is not integral
+	a := New(&err, []int{2, 1}, []int{3, 4}).Rational(&err)
+	assert.NilError(t, err)
+	inv := a.Inverse(&err)
+	assert.NilError(t, err)
+	assert.Equal(t, inv.String(), "[[4/5 -1/5] [-3/5 2/5]]")
+
+	a = New(&err, []int{2, 0, 1}, []int{0, 3, 0}, []int{1, 0, 1}).Rational(&err)
+	assert.NilError(t, err)
+	inv = a.Inverse(&err)
+	assert.NilError(t, err)
+	assert.Equal(t, inv.String(), "[[1 0 -1] [0 1/3 0] [-1 0 2]]")
+	i := New(&err, []int{1, 0, 0}, []int{0, 1, 0}, []int{0, 0, 1}).Rational(&err)
+	assert.NilError(t, err)
+	m := a.Multiply(&err, inv)
+	assert.NilError(t, err)
+	assert.Check(t, m.Equal(i))
+
+	a = New(&err, []int{1, 2}, []int{2, 4}).Rational(&err)
+	assert.NilError(t, err)
+	_ = a.Inverse(&err)
+	assert.ErrorContains(t, err, "cannot invert, matrix is singular")
+}
+
+func TestRationalSolve(t *testing.T) {
+	var err error
+
+	a := New(&err, []int{3, 2}, []int{1, 2}).Rational(&err)
+	assert.NilError(t, err)
+	b := New(&err, []int{1, 0}, []int{0, 1}).Rational(&err)
+	assert.NilError(t, err)
+	x := a.Solve(&err, b)
+	assert.NilError(t, err)
+	assert.Equal(t, x.String(), "[[1/2 -1/2] [-1/4 3/4]]")
+
+	c := New(&err, []int{1}, []int{2}, []int{3}).Rational(&err)
+	assert.NilError(t, err)
+	_ = a.Solve(&err, c)
+	assert.ErrorContains(t, err, "cannot solve due to incompatible dimensions")
+	err = nil
+
+	a = New(&err, []int{1, 2}, []int{2, 4}).Rational(&err)
+	assert.NilError(t, err)
+	_ = a.Solve(&err, b)
+	assert.ErrorContains(t, err, "cannot solve, matrix is singular")
+}
+
+func TestRationalDeterminant(t *testing.T) {
+	var err error
+
+	a := New(&err, []int{0, 2, 1}, []int{1, 0, 3}, []int{4, 1, 0}).Rational(&err)
+	assert.NilError(t, err)
+	assert.Equal(t, a.Determinant(&err).RatString(), "25")
+	assert.NilError(t, err)
+
+	b := New(&err, []float64{0.5, 1}, []float64{1, 0.5}).Rational(&err)
+	assert.NilError(t, err)
+	assert.Equal(t, b.Determinant(&err).RatString(), "-3/4")
+	assert.NilError(t, err)
+
+	c := New(&err, []int{1, 2}, []int{2, 4}).Rational(&err)
+	assert.NilError(t, err)
+	assert.Equal(t, c.Determinant(&err).Sign(), 0)
+	assert.NilError(t, err)
+
+	d := New(&err, []int{1, 2}).Rational(&err)
+	assert.NilError(t, err)
+	_ = d.Determinant(&err)
+	assert.ErrorContains(t, err, "cannot calculate the determinant of a non-square matrix")
+}
+
+func TestRationalRREF(t *testing.T) {
+	var err error
+
+	a := New(&err, []int{2, 1, 3}, []int{4, 2, 7}).Rational(&err)
+	assert.NilError(t, err)
+	m := a.RREF(&err)
+	assert.NilError(t, err)
+	assert.Equal(t, m.String(), "[[1 1/2 0] [0 0 1]]")
+	assert.Equal(t, a.Rank(&err), 2)
+	assert.NilError(t, err)
+	assert.Equal(t, a.String(), "[[2 1 3] [4 2 7]]")
+}
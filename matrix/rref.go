@@ -0,0 +1,300 @@
+package matrix
+
+import (
+	"errors"
+	"math"
+	"math/big"
+
+	"golang.org/x/exp/constraints"
+)
+
+// RREF calculates the reduced row echelon form of a matrix.
+// Floating point matrices are reduced with partial pivoting, treating values not
+// greater than DefaultPivotTolerance relative to the largest magnitude as zero.
+// Integer matrices are reduced exactly, and each row is then scaled by the
+// smallest factor that makes it integral, so pivots may be greater than one.
+// https://en.wikipedia.org/wiki/Row_echelon_form#Reduced_row_echelon_form
+func (a Matrix[T]) RREF(err *error) Matrix[T] {
+	// Avoid hiding previous errors.
+	if *err != nil {
+		return Matrix[T]{}
+	}
+
+	if !isInteger[T]() {
+		values := a.float64Values()
+		rrefFloat64(values)
+		return fromFloat64[T](values)
+	}
+
+	values := a.ratValues()
+	rrefRat(values)
+	for j := range values {
+		values[j] = integral(values[j])
+	}
+	return fromRat[T](err, values)
+}
+
+// Rank calculates the number of linearly independent rows of a matrix.
+func (a Matrix[T]) Rank(err *error) int {
+	// Avoid hiding previous errors.
+	if *err != nil {
+		return 0
+	}
+
+	return len(a.pivots())
+}
+
+// NullSpace calculates a basis for the null space of a matrix, returning the
+// basis vectors as the columns of the result. Integer matrices give integer
+// basis vectors. If the null space is trivial, the result has no columns.
+// https://en.wikipedia.org/wiki/Kernel_(linear_algebra)#Computation_by_Gaussian_elimination
+func (a Matrix[T]) NullSpace(err *error) Matrix[T] {
+	// Avoid hiding previous errors.
+	if *err != nil {
+		return Matrix[T]{}
+	}
+
+	n := a.Dimensions.Width
+	if !isInteger[T]() {
+		values := a.float64Values()
+		pivots := rrefFloat64(values)
+		free := freeColumns(pivots, n)
+		basis := make([][]float64, n)
+		for j := range basis {
+			basis[j] = make([]float64, len(free))
+		}
+		for k, f := range free {
+			basis[f][k] = 1
+			for j, p := range pivots {
+				basis[p][k] = -values[j][f]
+			}
+		}
+		return fromFloat64[T](basis)
+	}
+
+	values := a.ratValues()
+	pivots := rrefRat(values)
+	free := freeColumns(pivots, n)
+	vectors := make([][]*big.Rat, len(free))
+	for k, f := range free {
+		v := make([]*big.Rat, n)
+		for j := range v {
+			v[j] = new(big.Rat)
+		}
+		v[f].SetInt64(1)
+		for j, p := range pivots {
+			v[p].Neg(values[j][f])
+		}
+		vectors[k] = integral(v)
+	}
+
+	basis := make([][]*big.Rat, n)
+	for j := range basis {
+		basis[j] = make([]*big.Rat, len(free))
+		for k := range free {
+			basis[j][k] = vectors[k][j]
+		}
+	}
+	return fromRat[T](err, basis)
+}
+
+// ColumnSpace calculates a basis for the column space of a matrix, returning
+// the linearly independent columns of the matrix. If the matrix is zero, the
+// result has no columns.
+func (a Matrix[T]) ColumnSpace(err *error) Matrix[T] {
+	// Avoid hiding previous errors.
+	if *err != nil {
+		return Matrix[T]{}
+	}
+
+	pivots := a.pivots()
+	values := make([][]T, a.Dimensions.Height)
+	for j := range values {
+		values[j] = make([]T, len(pivots))
+		for k, p := range pivots {
+			values[j][k] = a.Values[j][p]
+		}
+	}
+
+	return Matrix[T]{
+		Dimensions: Dimension{
+			Width:  len(pivots),
+			Height: a.Dimensions.Height,
+		},
+		Values: values,
+	}
+}
+
+// pivots returns the pivot columns of the reduced row echelon form.
+func (a Matrix[T]) pivots() []int {
+	if isInteger[T]() {
+		return rrefRat(a.ratValues())
+	}
+	return rrefFloat64(a.float64Values())
+}
+
+// ratValues returns a copy of the matrix values converted to big.Rat.
+func (a Matrix[T]) ratValues() [][]*big.Rat {
+	values := make([][]*big.Rat, a.Dimensions.Height)
+	for j := 0; j < a.Dimensions.Height; j++ {
+		values[j] = make([]*big.Rat, a.Dimensions.Width)
+		for i := 0; i < a.Dimensions.Width; i++ {
+			if isInteger[T]() {
+				values[j][i] = new(big.Rat).SetInt(bigInt(a.Values[j][i]))
+			} else {
+				values[j][i] = new(big.Rat).SetFloat64(float64(a.Values[j][i]))
+			}
+		}
+	}
+	return values
+}
+
+// fromRat creates a Matrix from integral big.Rat values, reporting an error if
+// a value does not fit in T.
+func fromRat[T constraints.Integer | constraints.Float](err *error, values [][]*big.Rat) Matrix[T] {
+	height := len(values)
+	width := 0
+	if height > 0 {
+		width = len(values[0])
+	}
+
+	m := make([][]T, height)
+	for j := 0; j < height; j++ {
+		m[j] = make([]T, width)
+		for i := 0; i < width; i++ {
+			v, ok := fromBigInt[T](values[j][i].Num())
+			if !ok {
+				*err = errors.New("result overflows the matrix element type")
+				return Matrix[T]{}
+			}
+			m[j][i] = v
+		}
+	}
+
+	return Matrix[T]{
+		Dimensions: Dimension{
+			Width:  width,
+			Height: height,
+		},
+		Values: m,
+	}
+}
+
+// rrefFloat64 reduces values to reduced row echelon form in place using partial
+// pivoting, returning the pivot columns.
+func rrefFloat64(values [][]float64) []int {
+	m := len(values)
+	n := len(values[0])
+	limit := DefaultPivotTolerance * maxAbs(values)
+	pivots := []int{}
+
+	row := 0
+	for col := 0; col < n && row < m; col++ {
+		// Select the row with the largest pivot candidate.
+		p := row
+		for j := row + 1; j < m; j++ {
+			if math.Abs(values[j][col]) > math.Abs(values[p][col]) {
+				p = j
+			}
+		}
+		if values[p][col] == 0 || math.Abs(values[p][col]) <= limit {
+			for j := row; j < m; j++ {
+				values[j][col] = 0
+			}
+			continue
+		}
+		values[row], values[p] = values[p], values[row]
+
+		pivot := values[row][col]
+		for i := col; i < n; i++ {
+			values[row][i] /= pivot
+		}
+		for j := 0; j < m; j++ {
+			f := values[j][col]
+			if j == row || f == 0 {
+				continue
+			}
+			for i := col; i < n; i++ {
+				values[j][i] -= f * values[row][i]
+			}
+		}
+
+		pivots = append(pivots, col)
+		row++
+	}
+	return pivots
+}
+
+// rrefRat reduces values to reduced row echelon form in place using exact
+// arithmetic, returning the pivot columns.
+func rrefRat(values [][]*big.Rat) []int {
+	m := len(values)
+	n := len(values[0])
+	pivots := []int{}
+	t := new(big.Rat)
+
+	row := 0
+	for col := 0; col < n && row < m; col++ {
+		p := -1
+		for j := row; j < m; j++ {
+			if values[j][col].Sign() != 0 {
+				p = j
+				break
+			}
+		}
+		if p < 0 {
+			continue
+		}
+		values[row], values[p] = values[p], values[row]
+
+		pivot := new(big.Rat).Set(values[row][col])
+		for i := col; i < n; i++ {
+			values[row][i].Quo(values[row][i], pivot)
+		}
+		for j := 0; j < m; j++ {
+			if j == row || values[j][col].Sign() == 0 {
+				continue
+			}
+			f := new(big.Rat).Set(values[j][col])
+			for i := col; i < n; i++ {
+				values[j][i].Sub(values[j][i], t.Mul(f, values[row][i]))
+			}
+		}
+
+		pivots = append(pivots, col)
+		row++
+	}
+	return pivots
+}
+
+// integral scales a vector of rationals by the least common multiple of their
+// denominators so every element is an integer.
+func integral(v []*big.Rat) []*big.Rat {
+	lcm := big.NewInt(1)
+	gcd := new(big.Int)
+	for _, x := range v {
+		d := x.Denom()
+		gcd.GCD(nil, nil, lcm, d)
+		lcm.Mul(lcm, new(big.Int).Quo(d, gcd))
+	}
+
+	scale := new(big.Rat).SetInt(lcm)
+	for _, x := range v {
+		x.Mul(x, scale)
+	}
+	return v
+}
+
+// freeColumns returns the columns below n that are not pivot columns.
+func freeColumns(pivots []int, n int) []int {
+	free := []int{}
+	k := 0
+	for i := 0; i < n; i++ {
+		if k < len(pivots) && pivots[k] == i {
+			k++
+			continue
+		}
+		free = append(free, i)
+	}
+	return free
+}
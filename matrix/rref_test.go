@@ -0,0 +1,137 @@
+package matrix
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestRREF(t *testing.T) {
+	var err error
+
+	// Float
+	a := New(&err, []float64{1, 2, -1, -4}, []float64{2, 3, -1, -11}, []float64{-2, 0, -3, 22})
+	assert.NilError(t, err)
+	m := a.RREF(&err)
+	assert.NilError(t, err)
+	r := New(&err, []float64{1, 0, 0, -8}, []float64{0, 1, 0, 1}, []float64{0, 0, 1, -2})
+	assert.NilError(t, err)
+	assert.Check(t, m.ApproxEqual(r, 0.0001))
+
+	// Float with a dependent row
+	a = New(&err, []float64{1, 2, 3}, []float64{2, 4, 6}, []float64{1, 0, 1})
+	assert.NilError(t, err)
+	m = a.RREF(&err)
+	assert.NilError(t, err)
+	r = New(&err, []float64{1, 0, 1}, []float64{0, 1, 1}, []float64{0, 0, 0})
+	assert.NilError(t, err)
+	assert.Check(t, m.ApproxEqual(r, 0.0001))
+
+	// Integer with unit pivots
+	b := New(&err, []int{1, 2, -1, -4}, []int{2, 3, -1, -11}, []int{-2, 0, -3, 22})
+	assert.NilError(t, err)
+	n := b.RREF(&err)
+	assert.NilError(t, err)
+	s := New(&err, []int{1, 0, 0, -8}, []int{0, 1, 0, 1}, []int{0, 0, 1, -2})
+	assert.NilError(t, err)
+	assert.Check(t, n.Equal(s))
+
+	// Integer with fractional entries scaled to be integral
+	b = New(&err, []int{2, 1}, []int{4, 2})
+	assert.NilError(t, err)
+	n = b.RREF(&err)
+	assert.NilError(t, err)
+	s = New(&err, []int{2, 1}, []int{0, 0})
+	assert.NilError(t, err)
+	assert.Check(t, n.Equal(s))
+
+	b = New(&err, []int{3, 1, 2}, []int{1, 1, 0})
+	assert.NilError(t, err)
+	n = b.RREF(&err)
+	assert.NilError(t, err)
+	s = New(&err, []int{1, 0, 1}, []int{0, 1, -1})
+	assert.NilError(t, err)
+	assert.Check(t, n.Equal(s))
+}
+
+func TestRank(t *testing.T) {
+	var err error
+
+	a := New(&err, []float64{1, 2, 3}, []float64{2, 4, 6}, []float64{1, 0, 1})
+	assert.NilError(t, err)
+	assert.Equal(t, a.Rank(&err), 2)
+	assert.NilError(t, err)
+
+	a = NewIdentity[float64](&err, Dimension{Width: 4, Height: 4})
+	assert.NilError(t, err)
+	assert.Equal(t, a.Rank(&err), 4)
+	assert.NilError(t, err)
+
+	b := NewZero[int](&err, Dimension{Width: 3, Height: 2})
+	assert.NilError(t, err)
+	assert.Equal(t, b.Rank(&err), 0)
+	assert.NilError(t, err)
+
+	b = New(&err, []int{1, 2, 3}, []int{4, 5, 6}, []int{7, 8, 9})
+	assert.NilError(t, err)
+	assert.Equal(t, b.Rank(&err), 2)
+	assert.NilError(t, err)
+
+	// A float matrix that is singular to within the tolerance
+	a = New(&err, []float64{1, 1}, []float64{1, 1 + 1e-14})
+	assert.NilError(t, err)
+	assert.Equal(t, a.Rank(&err), 1)
+	assert.NilError(t, err)
+}
+
+func TestNullSpace(t *testing.T) {
+	var err error
+
+	// Float - validate A * N = 0
+	a := New(&err, []float64{1, 2, 3}, []float64{2, 4, 6}, []float64{1, 0, 1})
+	assert.NilError(t, err)
+	n := a.NullSpace(&err)
+	assert.NilError(t, err)
+	assert.Equal(t, n.Dimensions, Dimension{Width: 1, Height: 3})
+	z := a.Multiply(&err, n)
+	assert.NilError(t, err)
+	zero := NewZero[float64](&err, z.Dimensions)
+	assert.NilError(t, err)
+	assert.Check(t, z.ApproxEqual(zero, 0.0001))
+
+	// Integer with integer basis vectors
+	b := New(&err, []int{2, 1, 0, 3})
+	assert.NilError(t, err)
+	m := b.NullSpace(&err)
+	assert.NilError(t, err)
+	r := New(&err, []int{-1, 0, -3}, []int{2, 0, 0}, []int{0, 1, 0}, []int{0, 0, 2})
+	assert.NilError(t, err)
+	assert.Check(t, m.Equal(r))
+
+	// Trivial null space
+	a = NewIdentity[float64](&err, Dimension{Width: 2, Height: 2})
+	assert.NilError(t, err)
+	n = a.NullSpace(&err)
+	assert.NilError(t, err)
+	assert.Equal(t, n.Dimensions, Dimension{Width: 0, Height: 2})
+}
+
+func TestColumnSpace(t *testing.T) {
+	var err error
+
+	a := New(&err, []int{1, 2, 1}, []int{2, 4, 0}, []int{3, 6, 1})
+	assert.NilError(t, err)
+	c := a.ColumnSpace(&err)
+	assert.NilError(t, err)
+	r := New(&err, []int{1, 1}, []int{2, 0}, []int{3, 1})
+	assert.NilError(t, err)
+	assert.Check(t, c.Equal(r))
+
+	b := New(&err, []float64{0, 1}, []float64{0, 2})
+	assert.NilError(t, err)
+	d := b.ColumnSpace(&err)
+	assert.NilError(t, err)
+	s := New(&err, []float64{1}, []float64{2})
+	assert.NilError(t, err)
+	assert.Check(t, d.Equal(s))
+}
@@ -0,0 +1,214 @@
+package matrix
+
+import (
+	"errors"
+	"math"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Semiring defines the addition and multiplication used in place of + and * by
+// SemiringMultiply and SemiringPower. Zero must be the identity for Add and
+// absorbing for Multiply, and One must be the identity for Multiply.
+// https://en.wikipedia.org/wiki/Semiring
+type Semiring[T constraints.Integer | constraints.Float] struct {
+	Add      func(a, b T) T
+	Multiply func(a, b T) T
+	Zero     T
+	One      T
+}
+
+// Arithmetic returns the ordinary (+, *) semiring used by Multiply.
+func Arithmetic[T constraints.Integer | constraints.Float]() Semiring[T] {
+	return Semiring[T]{
+		Add:      func(a, b T) T { return a + b },
+		Multiply: func(a, b T) T { return a * b },
+		Zero:     0,
+		One:      1,
+	}
+}
+
+// MinPlus returns the tropical (min, +) semiring, where a product of adjacency
+// matrices gives shortest path lengths. Zero is +Inf for floating point types
+// and the largest value of T for integer types, and is never added to.
+// Integer sums that overflow saturate to Zero, or to the smallest value of T.
+// https://en.wikipedia.org/wiki/Tropical_semiring
+func MinPlus[T constraints.Integer | constraints.Float]() Semiring[T] {
+	inf := maxValue[T]()
+	return Semiring[T]{
+		Add: func(a, b T) T {
+			if b < a {
+				return b
+			}
+			return a
+		},
+		Multiply: func(a, b T) T {
+			if a == inf || b == inf {
+				return inf
+			}
+			return saturatingAdd(a, b)
+		},
+		Zero: inf,
+		One:  0,
+	}
+}
+
+// MaxPlus returns the (max, +) semiring, where a product of adjacency matrices
+// gives longest path lengths. Zero is -Inf for floating point types and the
+// smallest value of T for integer types, and is never added to.
+// Integer sums that overflow saturate to Zero, or to the largest value of T.
+// Unsigned types are not supported, since their smallest value is also One.
+// https://en.wikipedia.org/wiki/Max-plus_algebra
+func MaxPlus[T constraints.Signed | constraints.Float]() Semiring[T] {
+	inf := minValue[T]()
+	return Semiring[T]{
+		Add: func(a, b T) T {
+			if b > a {
+				return b
+			}
+			return a
+		},
+		Multiply: func(a, b T) T {
+			if a == inf || b == inf {
+				return inf
+			}
+			return saturatingAdd(a, b)
+		},
+		Zero: inf,
+		One:  0,
+	}
+}
+
+// Boolean returns the (OR, AND) semiring, where a product of adjacency matrices
+// gives reachability. Nonzero values are treated as true and results are 0 or 1.
+func Boolean[T constraints.Integer | constraints.Float]() Semiring[T] {
+	return Semiring[T]{
+		Add: func(a, b T) T {
+			if a != 0 || b != 0 {
+				return 1
+			}
+			return 0
+		},
+		Multiply: func(a, b T) T {
+			if a != 0 && b != 0 {
+				return 1
+			}
+			return 0
+		},
+		Zero: 0,
+		One:  1,
+	}
+}
+
+// SemiringMultiply multiplies the matrix by another matrix using the addition
+// and multiplication of the passed semiring.
+// The height of matix B must match the width of matrix A.
+func (a Matrix[T]) SemiringMultiply(err *error, b Matrix[T], s Semiring[T]) Matrix[T] {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return Matrix[T]{}
+	}
+
+	// Check matrices can be multiplied.
+	if a.Dimensions.Width != b.Dimensions.Height {
+		*err = errors.New("cannot multiply matrices due to incompatible dimensions")
+		return Matrix[T]{}
+	}
+
+	width := b.Dimensions.Width
+	height := a.Dimensions.Height
+	m := make([][]T, height)
+	for j := 0; j < height; j++ {
+		m[j] = make([]T, width)
+		for x := 0; x < width; x++ {
+			sum := s.Zero
+			for i := 0; i < a.Dimensions.Width; i++ {
+				sum = s.Add(sum, s.Multiply(a.Values[j][i], b.Values[i][x]))
+			}
+			m[j][x] = sum
+		}
+	}
+
+	return Matrix[T]{
+		Dimensions: Dimension{
+			Width:  width,
+			Height: height,
+		},
+		Values: m,
+	}
+}
+
+// SemiringPower raises a square matrix to the nth power over the passed semiring
+// using repeated squaring. The zeroth power is the semiring identity, with One
+// on the diagonal and Zero elsewhere.
+func (a Matrix[T]) SemiringPower(err *error, n int, s Semiring[T]) Matrix[T] {
+	// Avoid hiding previous errors.
+	if *err != nil {
+		return Matrix[T]{}
+	}
+
+	// Check the matrix is square.
+	if a.Dimensions.Height != a.Dimensions.Width {
+		*err = errors.New("cannot raise a non-square matrix to a power")
+		return Matrix[T]{}
+	}
+
+	if n < 0 {
+		*err = errors.New("cannot raise a matrix to a negative power over a semiring")
+		return Matrix[T]{}
+	}
+
+	size := a.Dimensions.Width
+	identity := make([][]T, size)
+	for j := range identity {
+		identity[j] = make([]T, size)
+		for i := range identity[j] {
+			identity[j][i] = s.Zero
+		}
+		identity[j][j] = s.One
+	}
+
+	multiply := func(a Matrix[T], err *error, b Matrix[T]) Matrix[T] {
+		return a.SemiringMultiply(err, b, s)
+	}
+	return power(err, a, n, Matrix[T]{Dimensions: a.Dimensions, Values: identity}, multiply)
+}
+
+// saturatingAdd returns a + b, clamped to the limits of T if it overflows.
+func saturatingAdd[T constraints.Integer | constraints.Float](a, b T) T {
+	s, overflow := addOverflows(a, b)
+	if !overflow {
+		return s
+	}
+	if b > 0 {
+		return maxValue[T]()
+	}
+	return minValue[T]()
+}
+
+// maxValue returns +Inf for floating point types and the largest value of
+// integer types.
+func maxValue[T constraints.Integer | constraints.Float]() T {
+	if !isInteger[T]() {
+		return T(math.Inf(1))
+	}
+	v := T(1)
+	for v*2+1 > v {
+		v = v*2 + 1
+	}
+	return v
+}
+
+// minValue returns -Inf for floating point types and the smallest value of
+// integer types.
+func minValue[T constraints.Integer | constraints.Float]() T {
+	if !isInteger[T]() {
+		return T(math.Inf(-1))
+	}
+	v := maxValue[T]()
+	// Signed types wrap from the largest value to the smallest one.
+	if v+1 < v {
+		return v + 1
+	}
+	return 0
+}
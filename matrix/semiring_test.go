@@ -0,0 +1,189 @@
+package matrix
+
+import (
+	"math"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestSemiringMultiply(t *testing.T) {
+	var err error
+
+	a := New(&err, []int{1, 2}, []int{3, 4})
+	assert.NilError(t, err)
+	b := New(&err, []int{5, 6}, []int{7, 8})
+	assert.NilError(t, err)
+
+	m := a.SemiringMultiply(&err, b, Arithmetic[int]())
+	assert.NilError(t, err)
+	assert.Check(t, m.Equal(a.Multiply(&err, b)))
+
+	m = a.SemiringMultiply(&err, b, MinPlus[int]())
+	assert.NilError(t, err)
+	assert.Equal(t, m.String(), "[[6 7] [8 9]]")
+
+	m = a.SemiringMultiply(&err, b, MaxPlus[int]())
+	assert.NilError(t, err)
+	assert.Equal(t, m.String(), "[[9 10] [11 12]]")
+
+	c := New(&err, []int{1, 0, 2})
+	assert.NilError(t, err)
+	_ = c.SemiringMultiply(&err, a, MinPlus[int]())
+	assert.ErrorContains(t, err, "cannot multiply matrices due to incompatible dimensions")
+}
+
+func TestSemiringShortestPaths(t *testing.T) {
+	var err error
+
+	// Edge weights of a directed graph, with Inf where there is no edge.
+	inf := math.Inf(1)
+	a := New(&err,
+		[]float64{0, 4, inf, 1},
+		[]float64{inf, 0, 1, inf},
+		[]float64{2, inf, 0, inf},
+		[]float64{inf, 2, 6, 0},
+	)
+	assert.NilError(t, err)
+
+	m := a.SemiringPower(&err, 3, MinPlus[float64]())
+	assert.NilError(t, err)
+	r := New(&err,
+		[]float64{0, 3, 4, 1},
+		[]float64{3, 0, 1, 4},
+		[]float64{2, 5, 0, 3},
+		[]float64{5, 2, 3, 0},
+	)
+	assert.NilError(t, err)
+	assert.Check(t, m.Equal(r))
+
+	// Integer weights use the largest value as infinity without overflowing.
+	inf8 := maxValue[int8]()
+	b := New(&err, []int8{0, 100}, []int8{inf8, 0})
+	assert.NilError(t, err)
+	n := b.SemiringPower(&err, 2, MinPlus[int8]())
+	assert.NilError(t, err)
+	assert.Equal(t, n.Values[1][0], inf8)
+	assert.Equal(t, n.Values[0][1], int8(100))
+
+	m = a.SemiringPower(&err, 0, MinPlus[float64]())
+	assert.NilError(t, err)
+	assert.Equal(t, m.Values[0][0], 0.0)
+	assert.Equal(t, m.Values[0][1], inf)
+}
+
+func TestSemiringLongestPaths(t *testing.T) {
+	var err error
+
+	// Task durations in a schedule, with -Inf where there is no dependency.
+	ninf := math.Inf(-1)
+	a := New(&err,
+		[]float64{ninf, 3, 2},
+		[]float64{ninf, ninf, 4},
+		[]float64{ninf, ninf, ninf},
+	)
+	assert.NilError(t, err)
+
+	m := a.SemiringPower(&err, 2, MaxPlus[float64]())
+	assert.NilError(t, err)
+	assert.Equal(t, m.Values[0][2], 7.0)
+	assert.Equal(t, m.Values[0][1], ninf)
+
+	// A 0-weight edge is distinct from no edge, and the zeroth power is the
+	// identity.
+	b := New(&err, []int{0, 5}, []int{math.MinInt, 1})
+	assert.NilError(t, err)
+	n := b.SemiringMultiply(&err, b, MaxPlus[int]())
+	assert.NilError(t, err)
+	assert.Equal(t, n.Values[0][0], 0)
+	assert.Equal(t, n.Values[0][1], 6)
+	assert.Equal(t, n.Values[1][0], math.MinInt)
+	n = b.SemiringPower(&err, 0, MaxPlus[int]())
+	assert.NilError(t, err)
+	assert.Equal(t, n.Values[0][0], 0)
+	assert.Equal(t, n.Values[0][1], math.MinInt)
+}
+
+func TestSemiringOverflow(t *testing.T) {
+	var err error
+
+	// Finite sums that overflow saturate instead of wrapping around.
+	a := New(&err, []int8{0, 100}, []int8{100, 0})
+	assert.NilError(t, err)
+	m := a.SemiringPower(&err, 2, MinPlus[int8]())
+	assert.NilError(t, err)
+	assert.Equal(t, m.String(), "[[0 100] [100 0]]")
+	m = a.SemiringPower(&err, 2, MaxPlus[int8]())
+	assert.NilError(t, err)
+	assert.Equal(t, m.String(), "[[127 100] [100 127]]")
+
+	c := New(&err, []int8{100})
+	assert.NilError(t, err)
+	m = c.SemiringPower(&err, 2, MinPlus[int8]())
+	assert.NilError(t, err)
+	assert.Equal(t, m.Values[0][0], maxValue[int8]())
+
+	b := New(&err, []int8{-100, -100}, []int8{-100, -100})
+	assert.NilError(t, err)
+	m = b.SemiringMultiply(&err, b, MinPlus[int8]())
+	assert.NilError(t, err)
+	assert.Equal(t, m.String(), "[[-128 -128] [-128 -128]]")
+	m = b.SemiringMultiply(&err, b, MaxPlus[int8]())
+	assert.NilError(t, err)
+	assert.Equal(t, m.String(), "[[-128 -128] [-128 -128]]")
+
+	u := New(&err, []uint8{200, 200})
+	v := New(&err, []uint8{100}, []uint8{250})
+	assert.NilError(t, err)
+	m8 := u.SemiringMultiply(&err, v, MinPlus[uint8]())
+	assert.NilError(t, err)
+	assert.Equal(t, m8.String(), "[[255]]")
+}
+
+func TestSemiringReachability(t *testing.T) {
+	var err error
+
+	a := New(&err,
+		[]uint8{0, 1, 0, 0},
+		[]uint8{0, 0, 1, 0},
+		[]uint8{0, 0, 0, 0},
+		[]uint8{1, 0, 0, 0},
+	)
+	assert.NilError(t, err)
+
+	m := a.SemiringPower(&err, 2, Boolean[uint8]())
+	assert.NilError(t, err)
+	assert.Equal(t, m.String(), "[[0 0 1 0] [0 0 0 0] [0 0 0 0] [0 1 0 0]]")
+
+	// Reachability in at most three steps
+	i := NewIdentity[uint8](&err, a.Dimensions)
+	assert.NilError(t, err)
+	r := a.Add(&err, i).SemiringPower(&err, 3, Boolean[uint8]())
+	assert.NilError(t, err)
+	assert.Equal(t, r.String(), "[[1 1 1 0] [0 1 1 0] [0 0 1 0] [1 1 1 1]]")
+}
+
+func TestSemiringPowerErrors(t *testing.T) {
+	var err error
+
+	a := New(&err, []int{1, 2})
+	assert.NilError(t, err)
+	_ = a.SemiringPower(&err, 2, Boolean[int]())
+	assert.ErrorContains(t, err, "cannot raise a non-square matrix to a power")
+	err = nil
+
+	b := New(&err, []int{1})
+	assert.NilError(t, err)
+	_ = b.SemiringPower(&err, -1, Boolean[int]())
+	assert.ErrorContains(t, err, "cannot raise a matrix to a negative power over a semiring")
+}
+
+func TestSemiringLimits(t *testing.T) {
+	assert.Equal(t, maxValue[int8](), int8(math.MaxInt8))
+	assert.Equal(t, minValue[int8](), int8(math.MinInt8))
+	assert.Equal(t, maxValue[uint16](), uint16(math.MaxUint16))
+	assert.Equal(t, minValue[uint16](), uint16(0))
+	assert.Equal(t, maxValue[int64](), int64(math.MaxInt64))
+	assert.Equal(t, minValue[int](), math.MinInt)
+	assert.Equal(t, maxValue[float32](), float32(math.Inf(1)))
+}
@@ -0,0 +1,93 @@
+package matrix
+
+import (
+	"errors"
+	"math"
+)
+
+// Solve solves A * X = B for X using an LU factorization of A.
+// B may have any number of columns.
+func (a Matrix[T]) Solve(err *error, b Matrix[T]) Matrix[T] {
+	return a.SolveRefined(err, b, 0)
+}
+
+// SolveRefined solves A * X = B for X and then improves the solution with up to
+// the given number of iterative refinement steps. Each step solves for the
+// correction A * D = B - A * X, reusing the factorization of A.
+// https://en.wikipedia.org/wiki/Iterative_refinement
+func (a Matrix[T]) SolveRefined(err *error, b Matrix[T], iterations int) Matrix[T] {
+	return a.SolveRefinedWithTolerance(err, b, iterations, DefaultPivotTolerance)
+}
+
+// SolveRefinedWithTolerance solves A * X = B for X as with SolveRefined. The
+// matrix is reported as singular if a pivot is not greater than tolerance
+// relative to the largest magnitude in A.
+func (a Matrix[T]) SolveRefinedWithTolerance(err *error, b Matrix[T], iterations int, tolerance float64) Matrix[T] {
+	// Avoid hiding previous errors.
+	if *err != nil {
+		return Matrix[T]{}
+	}
+
+	// Check the system can be solved.
+	if a.Dimensions.Height != a.Dimensions.Width {
+		*err = errors.New("cannot solve a non-square system")
+		return Matrix[T]{}
+	}
+	if a.Dimensions.Height != b.Dimensions.Height {
+		*err = errors.New("cannot solve due to incompatible dimensions")
+		return Matrix[T]{}
+	}
+	if iterations < 0 {
+		*err = errors.New("refinement iterations cannot be negative")
+		return Matrix[T]{}
+	}
+
+	f := a.LUWithTolerance(err, tolerance)
+	if *err != nil {
+		return Matrix[T]{}
+	}
+	*err = f.pivotError("solve")
+	if *err != nil {
+		return Matrix[T]{}
+	}
+
+	av := a.float64Values()
+	bv := b.float64Values()
+	x := f.solve(bv)
+	for k := 0; k < iterations; k++ {
+		d := f.solve(residual(av, x, bv))
+
+		// Stop once the corrections no longer change the solution.
+		changed := false
+		for j := range x {
+			for i := range x[j] {
+				next := x[j][i] + d[j][i]
+				if next != x[j][i] {
+					changed = true
+				}
+				x[j][i] = next
+			}
+		}
+		if !changed {
+			break
+		}
+	}
+
+	return fromFloat64[T](x)
+}
+
+// residual calculates B - A * X, using fused multiply-adds to limit rounding.
+func residual(a, x, b [][]float64) [][]float64 {
+	r := make([][]float64, len(b))
+	for j := range b {
+		r[j] = make([]float64, len(b[j]))
+		for i := range b[j] {
+			sum := b[j][i]
+			for k := range x {
+				sum = math.FMA(-a[j][k], x[k][i], sum)
+			}
+			r[j][i] = sum
+		}
+	}
+	return r
+}
@@ -0,0 +1,135 @@
+package matrix
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestSolve(t *testing.T) {
+	var err error
+
+	// Single right-hand side
+	a := New(&err, []float64{3, 2, -1}, []float64{2, -2, 4}, []float64{-1, 0.5, -1})
+	assert.NilError(t, err)
+	b := New(&err, []float64{1}, []float64{-2}, []float64{0})
+	assert.NilError(t, err)
+	x := a.Solve(&err, b)
+	assert.NilError(t, err)
+	r := New(&err, []float64{1}, []float64{-2}, []float64{-2})
+	assert.NilError(t, err)
+	assert.Check(t, x.ApproxEqual(r, 0.0001))
+
+	// Multiple right-hand sides
+	b = New(&err, []float64{1, 3}, []float64{-2, 2}, []float64{0, -1})
+	assert.NilError(t, err)
+	x = a.Solve(&err, b)
+	assert.NilError(t, err)
+	ax := a.Multiply(&err, x)
+	assert.NilError(t, err)
+	assert.Check(t, ax.ApproxEqual(b, 0.0001))
+
+	// Integer system with an integer solution
+	c := New(&err, []int{2, 1}, []int{1, 3})
+	assert.NilError(t, err)
+	d := New(&err, []int{5}, []int{10})
+	assert.NilError(t, err)
+	y := c.Solve(&err, d)
+	assert.NilError(t, err)
+	s := New(&err, []int{1}, []int{3})
+	assert.NilError(t, err)
+	assert.Check(t, y.Equal(s))
+}
+
+func TestSolveRefined(t *testing.T) {
+	var err error
+
+	// 6x6 Hilbert matrix with a known solution of ones.
+	n := 6
+	values := make([][]float64, n)
+	rhs := make([][]float64, n)
+	ones := make([][]float64, n)
+	for j := 0; j < n; j++ {
+		values[j] = make([]float64, n)
+		sum := 0.0
+		for i := 0; i < n; i++ {
+			values[j][i] = 1.0 / float64(i+j+1)
+			sum += values[j][i]
+		}
+		rhs[j] = []float64{sum}
+		ones[j] = []float64{1}
+	}
+	a := New(&err, values...)
+	assert.NilError(t, err)
+	b := New(&err, rhs...)
+	assert.NilError(t, err)
+	r := New(&err, ones...)
+	assert.NilError(t, err)
+
+	x := a.SolveRefined(&err, b, 3)
+	assert.NilError(t, err)
+	assert.Check(t, x.ApproxEqual(r, 1e-6))
+	assert.Check(t, maxAbs(residual(a.Values, x.Values, b.Values)) < 1e-12)
+}
+
+func TestSolveErrors(t *testing.T) {
+	var err error
+
+	a := New(&err, []float64{1, 2, 3}, []float64{4, 5, 6})
+	assert.NilError(t, err)
+	b := New(&err, []float64{1}, []float64{2})
+	assert.NilError(t, err)
+	_ = a.Solve(&err, b)
+	assert.ErrorContains(t, err, "cannot solve a non-square system")
+	err = nil
+
+	a = New(&err, []float64{1, 2}, []float64{2, 4})
+	assert.NilError(t, err)
+	m := a.Solve(&err, b)
+	assert.ErrorContains(t, err, "cannot solve, matrix is singular")
+	assert.Check(t, m.Equal(Matrix[float64]{}))
+	err = nil
+
+	b = New(&err, []float64{1}, []float64{2}, []float64{3})
+	assert.NilError(t, err)
+	_ = a.Solve(&err, b)
+	assert.ErrorContains(t, err, "cannot solve due to incompatible dimensions")
+	err = nil
+
+	a = New(&err, []float64{1, 0}, []float64{0, 1})
+	assert.NilError(t, err)
+	b = New(&err, []float64{1}, []float64{2})
+	assert.NilError(t, err)
+	_ = a.SolveRefined(&err, b, -1)
+	assert.ErrorContains(t, err, "refinement iterations cannot be negative")
+	err = nil
+
+	a = New(&err, []float64{1e13, 0}, []float64{0, 1})
+	assert.NilError(t, err)
+	_ = a.Solve(&err, b)
+	assert.ErrorContains(t, err, "cannot solve, matrix is nearly singular")
+	err = nil
+
+	_ = a.SolveRefinedWithTolerance(&err, b, 0, -1)
+	assert.ErrorContains(t, err, "pivot tolerance cannot be negative")
+}
+
+func TestSolveRefinedWithTolerance(t *testing.T) {
+	var err error
+
+	a := New(&err, []float64{1e13, 0}, []float64{0, 1})
+	assert.NilError(t, err)
+	b := New(&err, []float64{1e13}, []float64{2})
+	assert.NilError(t, err)
+	x := a.SolveRefinedWithTolerance(&err, b, 2, 0)
+	assert.NilError(t, err)
+	r := New(&err, []float64{1}, []float64{2})
+	assert.NilError(t, err)
+	assert.Check(t, x.Equal(r))
+
+	// Exactly singular matrices are still reported.
+	a = New(&err, []float64{1, 2}, []float64{2, 4})
+	assert.NilError(t, err)
+	_ = a.SolveRefinedWithTolerance(&err, b, 0, 0)
+	assert.ErrorContains(t, err, "cannot solve, matrix is singular")
+}
@@ -0,0 +1,244 @@
+package matrix
+
+import (
+	"errors"
+	"math"
+	"sort"
+
+	"golang.org/x/exp/constraints"
+)
+
+// SVD is the singular value decomposition of an m×n matrix, such that
+// A = U * Sigma * VT. The factors are floating point for every element type.
+type SVD[T constraints.Integer | constraints.Float] struct {
+	// U has orthonormal columns. It is m×m for a full decomposition and
+	// m×k for a thin decomposition, where k = min(m, n).
+	U Matrix[float64]
+	// Sigma is diagonal. It is m×n for a full decomposition and k×k for a
+	// thin decomposition.
+	Sigma Matrix[float64]
+	// VT has orthonormal rows. It is n×n for a full decomposition and k×n
+	// for a thin decomposition.
+	VT Matrix[float64]
+	// Values holds the k singular values in descending order.
+	Values []float64
+}
+
+// SVD calculates the full singular value decomposition of a matrix using the
+// one-sided Jacobi method.
+func (a Matrix[T]) SVD(err *error) SVD[T] {
+	return a.svd(err, true)
+}
+
+// ThinSVD calculates the thin singular value decomposition of a matrix, which
+// omits the columns of U and rows of VT that only multiply zeros in Sigma.
+func (a Matrix[T]) ThinSVD(err *error) SVD[T] {
+	return a.svd(err, false)
+}
+
+func (a Matrix[T]) svd(err *error, full bool) SVD[T] {
+	// Avoid hiding previous errors.
+	if *err != nil {
+		return SVD[T]{}
+	}
+
+	if !a.isFinite() {
+		*err = errors.New("cannot calculate the singular value decomposition of a matrix with non-finite values")
+		return SVD[T]{}
+	}
+
+	u, s, v, ok := svd(a.float64Values(), full)
+	if !ok {
+		*err = errors.New("cannot calculate the singular value decomposition, Jacobi iteration did not converge")
+		return SVD[T]{}
+	}
+
+	sigma := make([][]float64, len(u[0]))
+	for j := range sigma {
+		sigma[j] = make([]float64, len(v[0]))
+		if j < len(s) {
+			sigma[j][j] = s[j]
+		}
+	}
+
+	vt := make([][]float64, len(v[0]))
+	for j := range vt {
+		vt[j] = make([]float64, len(v))
+		for i := range v {
+			vt[j][i] = v[i][j]
+		}
+	}
+
+	return SVD[T]{
+		U:      fromFloat64[float64](u),
+		Sigma:  fromFloat64[float64](sigma),
+		VT:     fromFloat64[float64](vt),
+		Values: s,
+	}
+}
+
+// svd calculates the singular value decomposition A = U * diag(S) * V' of an
+// m×n matrix. S holds k = min(m, n) values in descending order. For a full
+// decomposition U is m×m and V is n×n, otherwise U is m×k and V is n×k.
+// It also reports whether the Jacobi iteration converged.
+func svd(a [][]float64, full bool) ([][]float64, []float64, [][]float64, bool) {
+	m := len(a)
+	n := len(a[0])
+	if m < n {
+		// Decompose A' = V * S * U' instead, so the Jacobi iteration always
+		// works on a matrix with at least as many rows as columns.
+		at := make([][]float64, n)
+		for j := 0; j < n; j++ {
+			at[j] = make([]float64, m)
+			for i := 0; i < m; i++ {
+				at[j][i] = a[i][j]
+			}
+		}
+		v, s, u, ok := svd(at, full)
+		return u, s, v, ok
+	}
+
+	// Store the columns of W = A * V as rows so they can be rotated easily.
+	w := make([][]float64, n)
+	v := identity(n)
+	for j := 0; j < n; j++ {
+		w[j] = make([]float64, m)
+		for i := 0; i < m; i++ {
+			w[j][i] = a[i][j]
+		}
+	}
+
+	// Columns that are negligible relative to the whole matrix are treated as
+	// zero, since rotating them only rescales rounding error.
+	const eps = 1e-15
+	negligible := 0.0
+	for j := 0; j < n; j++ {
+		for i := 0; i < m; i++ {
+			negligible += w[j][i] * w[j][i]
+		}
+	}
+	negligible *= eps * eps
+
+	// Rotate pairs of columns until they are all orthogonal.
+	// https://en.wikipedia.org/wiki/Jacobi_eigenvalue_algorithm#Singular_value_decomposition
+	converged := false
+	for sweep := 0; sweep < 60 && !converged; sweep++ {
+		rotated := false
+		for p := 0; p < n-1; p++ {
+			for q := p + 1; q < n; q++ {
+				alpha, beta, gamma := 0.0, 0.0, 0.0
+				for i := 0; i < m; i++ {
+					alpha += w[p][i] * w[p][i]
+					beta += w[q][i] * w[q][i]
+					gamma += w[p][i] * w[q][i]
+				}
+				if gamma == 0 || math.Abs(gamma) <= eps*math.Sqrt(alpha*beta) ||
+					alpha <= negligible || beta <= negligible {
+					continue
+				}
+				rotated = true
+
+				zeta := (beta - alpha) / (2 * gamma)
+				t := math.Copysign(1, zeta) / (math.Abs(zeta) + math.Sqrt(1+zeta*zeta))
+				c := 1 / math.Sqrt(1+t*t)
+				s := c * t
+				rotate(w[p], w[q], c, s)
+				rotate(v[p], v[q], c, s)
+			}
+		}
+		converged = !rotated
+	}
+
+	// The singular values are the column norms of W.
+	s := make([]float64, n)
+	order := make([]int, n)
+	for j := 0; j < n; j++ {
+		for i := 0; i < m; i++ {
+			s[j] = math.Hypot(s[j], w[j][i])
+		}
+		order[j] = j
+	}
+	sort.SliceStable(order, func(x, y int) bool {
+		return s[order[x]] > s[order[y]]
+	})
+
+	// Normalize the columns of W to get U, skipping zero singular values.
+	limit := eps * float64(m) * s[order[0]]
+	values := make([]float64, n)
+	ucols := make([][]float64, 0, m)
+	vcols := make([][]float64, n)
+	for k, j := range order {
+		values[k] = s[j]
+		vcols[k] = v[j]
+		if s[j] > limit {
+			col := make([]float64, m)
+			for i := 0; i < m; i++ {
+				col[i] = w[j][i] / s[j]
+			}
+			ucols = append(ucols, col)
+		} else {
+			values[k] = 0
+		}
+	}
+	size := n
+	if full {
+		size = m
+	}
+	ucols = completeBasis(ucols, m, size)
+
+	return columns(ucols, m), values, columns(vcols, n), converged
+}
+
+// rotate applies a plane rotation to the vectors x and y.
+func rotate(x, y []float64, c, s float64) {
+	for i := range x {
+		x[i], y[i] = c*x[i]-s*y[i], s*x[i]+c*y[i]
+	}
+}
+
+// completeBasis extends a set of orthonormal vectors of length m to size
+// vectors using Gram-Schmidt orthogonalization of the standard basis.
+func completeBasis(basis [][]float64, m, size int) [][]float64 {
+	for e := 0; e < m && len(basis) < size; e++ {
+		x := make([]float64, m)
+		x[e] = 1
+
+		// Orthogonalize twice to limit the loss of orthogonality.
+		for pass := 0; pass < 2; pass++ {
+			for _, b := range basis {
+				dot := 0.0
+				for i := 0; i < m; i++ {
+					dot += b[i] * x[i]
+				}
+				for i := 0; i < m; i++ {
+					x[i] -= dot * b[i]
+				}
+			}
+		}
+
+		norm := 0.0
+		for i := 0; i < m; i++ {
+			norm = math.Hypot(norm, x[i])
+		}
+		if norm < 1e-8 {
+			continue
+		}
+		for i := 0; i < m; i++ {
+			x[i] /= norm
+		}
+		basis = append(basis, x)
+	}
+	return basis
+}
+
+// columns builds an m×len(cols) matrix whose columns are cols.
+func columns(cols [][]float64, m int) [][]float64 {
+	values := make([][]float64, m)
+	for j := 0; j < m; j++ {
+		values[j] = make([]float64, len(cols))
+		for i := range cols {
+			values[j][i] = cols[i][j]
+		}
+	}
+	return values
+}
@@ -0,0 +1,138 @@
+package matrix
+
+import (
+	"math"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func checkSVD(t *testing.T, a Matrix[float64], f SVD[float64]) {
+	t.Helper()
+	var err error
+
+	// A = U * Sigma * VT
+	m := f.U.Multiply(&err, f.Sigma).Multiply(&err, f.VT)
+	assert.NilError(t, err)
+	assert.Check(t, m.ApproxEqual(a, 0.0001))
+
+	// U and VT are orthonormal.
+	uu := f.U.Transpose(&err).Multiply(&err, f.U)
+	assert.NilError(t, err)
+	i := NewIdentity[float64](&err, uu.Dimensions)
+	assert.NilError(t, err)
+	assert.Check(t, uu.ApproxEqual(i, 0.0001))
+	vv := f.VT.Multiply(&err, f.VT.Transpose(&err))
+	assert.NilError(t, err)
+	i = NewIdentity[float64](&err, vv.Dimensions)
+	assert.NilError(t, err)
+	assert.Check(t, vv.ApproxEqual(i, 0.0001))
+
+	// Singular values are non-negative and descending.
+	for k := range f.Values {
+		assert.Check(t, f.Values[k] >= 0)
+		if k > 0 {
+			assert.Check(t, f.Values[k] <= f.Values[k-1])
+		}
+	}
+}
+
+func TestSVD(t *testing.T) {
+	var err error
+
+	// 2x2 with known singular values
+	a := New(&err, []float64{3, 0}, []float64{4, 5})
+	assert.NilError(t, err)
+	f := a.SVD(&err)
+	assert.NilError(t, err)
+	checkSVD(t, a, f)
+	assert.Check(t, math.Abs(f.Values[0]-math.Sqrt(45)) < 0.0001)
+	assert.Check(t, math.Abs(f.Values[1]-math.Sqrt(5)) < 0.0001)
+
+	// 4x2
+	a = New(&err, []float64{1, 2}, []float64{3, 4}, []float64{5, 6}, []float64{7, 8})
+	assert.NilError(t, err)
+	f = a.SVD(&err)
+	assert.NilError(t, err)
+	checkSVD(t, a, f)
+	assert.Equal(t, f.U.Dimensions, Dimension{Width: 4, Height: 4})
+	assert.Equal(t, f.Sigma.Dimensions, Dimension{Width: 2, Height: 4})
+	assert.Equal(t, f.VT.Dimensions, Dimension{Width: 2, Height: 2})
+
+	// 2x3
+	a = New(&err, []float64{3, 2, 2}, []float64{2, 3, -2})
+	assert.NilError(t, err)
+	f = a.SVD(&err)
+	assert.NilError(t, err)
+	checkSVD(t, a, f)
+	assert.Check(t, math.Abs(f.Values[0]-5) < 0.0001)
+	assert.Check(t, math.Abs(f.Values[1]-3) < 0.0001)
+	assert.Equal(t, f.VT.Dimensions, Dimension{Width: 3, Height: 3})
+
+	// Rank deficient
+	a = New(&err, []float64{1, 2, 3}, []float64{2, 4, 6}, []float64{1, 1, 1})
+	assert.NilError(t, err)
+	f = a.SVD(&err)
+	assert.NilError(t, err)
+	checkSVD(t, a, f)
+	assert.Equal(t, f.Values[2], 0.0)
+
+	// Integer matrices have floating point factors.
+	b := New(&err, []int{3, 0}, []int{4, 5})
+	assert.NilError(t, err)
+	g := b.SVD(&err)
+	assert.NilError(t, err)
+	m := g.U.Multiply(&err, g.Sigma).Multiply(&err, g.VT)
+	assert.NilError(t, err)
+	assert.Check(t, m.ApproxEqual(New(&err, []float64{3, 0}, []float64{4, 5}), 1e-12))
+	assert.Check(t, math.Abs(g.Sigma.Values[1][1]-math.Sqrt(5)) < 1e-12)
+
+	// Zero
+	a = NewZero[float64](&err, Dimension{Width: 2, Height: 3})
+	assert.NilError(t, err)
+	f = a.SVD(&err)
+	assert.NilError(t, err)
+	checkSVD(t, a, f)
+}
+
+func TestThinSVD(t *testing.T) {
+	var err error
+
+	// 4x2
+	a := New(&err, []float64{1, 2}, []float64{3, 4}, []float64{5, 6}, []float64{7, 8})
+	assert.NilError(t, err)
+	f := a.ThinSVD(&err)
+	assert.NilError(t, err)
+	checkSVD(t, a, f)
+	assert.Equal(t, f.U.Dimensions, Dimension{Width: 2, Height: 4})
+	assert.Equal(t, f.Sigma.Dimensions, Dimension{Width: 2, Height: 2})
+	assert.Equal(t, f.VT.Dimensions, Dimension{Width: 2, Height: 2})
+
+	// 2x3
+	a = New(&err, []float64{3, 2, 2}, []float64{2, 3, -2})
+	assert.NilError(t, err)
+	f = a.ThinSVD(&err)
+	assert.NilError(t, err)
+	checkSVD(t, a, f)
+	assert.Equal(t, f.U.Dimensions, Dimension{Width: 2, Height: 2})
+	assert.Equal(t, f.VT.Dimensions, Dimension{Width: 3, Height: 2})
+}
+
+func TestSVDErrors(t *testing.T) {
+	var err error
+
+	a := New(&err, []float64{math.NaN(), 1}, []float64{1, 1})
+	assert.NilError(t, err)
+	_ = a.SVD(&err)
+	assert.ErrorContains(t, err, "cannot calculate the singular value decomposition of a matrix with non-finite values")
+	err = nil
+
+	a = New(&err, []float64{math.Inf(1), 1}, []float64{1, 1})
+	assert.NilError(t, err)
+	_ = a.ThinSVD(&err)
+	assert.ErrorContains(t, err, "cannot calculate the singular value decomposition of a matrix with non-finite values")
+
+	// The iteration never settles on NaN values.
+	_, _, _, ok := svd([][]float64{{math.NaN(), 1}, {1, 1}}, false)
+	assert.Check(t, !ok)
+}
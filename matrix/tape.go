@@ -0,0 +1,258 @@
+package matrix
+
+import (
+	"errors"
+)
+
+// Tape records operations on matrices so that gradients can be calculated with
+// reverse-mode automatic differentiation.
+// https://en.wikipedia.org/wiki/Automatic_differentiation#Reverse_accumulation
+type Tape struct {
+	nodes []*Node
+}
+
+// Node is a matrix recorded on a Tape. Operations on nodes calculate their
+// value immediately and record how to propagate gradients back to their inputs.
+type Node struct {
+	Value Matrix[float64]
+	// Gradient is the gradient of the output passed to the latest call of
+	// Backward with respect to this node. It is zero if the output does not
+	// depend on the node.
+	Gradient Matrix[float64]
+
+	tape     *Tape
+	backward func(gradient Matrix[float64])
+}
+
+// NewTape creates an empty Tape.
+func NewTape() *Tape {
+	return &Tape{}
+}
+
+// Variable records an input matrix on the tape.
+func (t *Tape) Variable(err *error, value Matrix[float64]) *Node {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return nil
+	}
+
+	return t.record(value.Clone(), nil)
+}
+
+// Multiply multiplies the matrix by another matrix.
+// The height of matix B must match the width of matrix A.
+func (a *Node) Multiply(err *error, b *Node) *Node {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return nil
+	}
+
+	if a.tape != b.tape {
+		*err = errors.New("cannot combine nodes from different tapes")
+		return nil
+	}
+
+	value := a.Value.Multiply(err, b.Value)
+	if *err != nil {
+		return nil
+	}
+	return a.tape.record(value, func(gradient Matrix[float64]) {
+		var err error
+		a.accumulate(gradient.Multiply(&err, b.Value.Transpose(&err)))
+		b.accumulate(a.Value.Transpose(&err).Multiply(&err, gradient))
+	})
+}
+
+// MultiplyScalar multiplies the Matrix by a scalar.
+func (a *Node) MultiplyScalar(err *error, x float64) *Node {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return nil
+	}
+
+	return a.tape.record(a.Value.MultiplyScalar(err, x), func(gradient Matrix[float64]) {
+		var err error
+		a.accumulate(gradient.MultiplyScalar(&err, x))
+	})
+}
+
+// Add a matrix to another one.
+// The dimensions of the matrices must match.
+func (a *Node) Add(err *error, b *Node) *Node {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return nil
+	}
+
+	if a.tape != b.tape {
+		*err = errors.New("cannot combine nodes from different tapes")
+		return nil
+	}
+
+	value := a.Value.Add(err, b.Value)
+	if *err != nil {
+		return nil
+	}
+	return a.tape.record(value, func(gradient Matrix[float64]) {
+		a.accumulate(gradient)
+		b.accumulate(gradient)
+	})
+}
+
+// Subtract a matrix from another one.
+// The dimensions of the matrices must match.
+func (a *Node) Subtract(err *error, b *Node) *Node {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return nil
+	}
+
+	if a.tape != b.tape {
+		*err = errors.New("cannot combine nodes from different tapes")
+		return nil
+	}
+
+	value := a.Value.Subtract(err, b.Value)
+	if *err != nil {
+		return nil
+	}
+	return a.tape.record(value, func(gradient Matrix[float64]) {
+		var err error
+		a.accumulate(gradient)
+		b.accumulate(gradient.MultiplyScalar(&err, -1))
+	})
+}
+
+// Transpose calculates the transpose of a Matrix.
+func (a *Node) Transpose(err *error) *Node {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return nil
+	}
+
+	return a.tape.record(a.Value.Transpose(err), func(gradient Matrix[float64]) {
+		var err error
+		a.accumulate(gradient.Transpose(&err))
+	})
+}
+
+// Inverse a matrix, propagating the gradient -A⁻ᵀ G A⁻ᵀ.
+func (a *Node) Inverse(err *error) *Node {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return nil
+	}
+
+	value := a.Value.Inverse(err)
+	if *err != nil {
+		return nil
+	}
+	return a.tape.record(value, func(gradient Matrix[float64]) {
+		var err error
+		t := value.Transpose(&err)
+		a.accumulate(t.Multiply(&err, gradient).Multiply(&err, t).MultiplyScalar(&err, -1))
+	})
+}
+
+// Apply applies the function f to each value of the matrix. The derivative df
+// of f is used to propagate gradients.
+func (a *Node) Apply(err *error, f, df func(float64) float64) *Node {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return nil
+	}
+
+	value := a.Value.Clone()
+	for _, row := range value.Values {
+		for i, v := range row {
+			row[i] = f(v)
+		}
+	}
+	return a.tape.record(value, func(gradient Matrix[float64]) {
+		delta := gradient.Clone()
+		for j, row := range delta.Values {
+			for i := range row {
+				row[i] *= df(a.Value.Values[j][i])
+			}
+		}
+		a.accumulate(delta)
+	})
+}
+
+// Sum calculates the sum of the values of the matrix as a 1×1 matrix, which is
+// useful for reducing a matrix to a scalar loss.
+func (a *Node) Sum(err *error) *Node {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return nil
+	}
+
+	sum := 0.0
+	for _, row := range a.Value.Values {
+		for _, v := range row {
+			sum += v
+		}
+	}
+	return a.tape.record(New(err, []float64{sum}), func(gradient Matrix[float64]) {
+		var err error
+		delta := NewZero[float64](&err, a.Value.Dimensions)
+		for _, row := range delta.Values {
+			for i := range row {
+				row[i] = gradient.Values[0][0]
+			}
+		}
+		a.accumulate(delta)
+	})
+}
+
+// Backward calculates the gradient of the sum of the values of the node with
+// respect to every node recorded on the tape before it, storing the results in
+// their Gradient fields. For a 1×1 node this is the gradient of its value.
+func (a *Node) Backward(err *error) {
+	// Avoid hiding previous errors
+	if *err != nil {
+		return
+	}
+
+	nodes := a.tape.nodes
+	for _, n := range nodes {
+		n.Gradient = NewZero[float64](err, n.Value.Dimensions)
+	}
+	for _, row := range a.Gradient.Values {
+		for i := range row {
+			row[i] = 1
+		}
+	}
+
+	// Nodes are recorded after their inputs, so visiting them in reverse
+	// propagates each gradient only once it is complete.
+	k := len(nodes) - 1
+	for nodes[k] != a {
+		k--
+	}
+	for ; k >= 0; k-- {
+		if nodes[k].backward != nil {
+			nodes[k].backward(nodes[k].Gradient)
+		}
+	}
+}
+
+// record appends a node to the tape.
+func (t *Tape) record(value Matrix[float64], backward func(Matrix[float64])) *Node {
+	n := &Node{
+		Value:    value,
+		tape:     t,
+		backward: backward,
+	}
+	t.nodes = append(t.nodes, n)
+	return n
+}
+
+// accumulate adds delta to the gradient of the node.
+func (a *Node) accumulate(delta Matrix[float64]) {
+	for j, row := range delta.Values {
+		for i, v := range row {
+			a.Gradient.Values[j][i] += v
+		}
+	}
+}
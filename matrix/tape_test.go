@@ -0,0 +1,132 @@
+package matrix
+
+import (
+	"math"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+// numericalGradient calculates the gradient of f at x using central differences.
+func numericalGradient(t *testing.T, f func(Matrix[float64]) float64, x Matrix[float64]) Matrix[float64] {
+	const h = 1e-6
+	var err error
+	g := NewZero[float64](&err, x.Dimensions)
+	assert.NilError(t, err)
+	for j := range x.Values {
+		for i := range x.Values[j] {
+			p, m := x.Clone(), x.Clone()
+			p.Values[j][i] += h
+			m.Values[j][i] -= h
+			g.Values[j][i] = (f(p) - f(m)) / (2 * h)
+		}
+	}
+	return g
+}
+
+func TestTapeLinearRegression(t *testing.T) {
+	var err error
+
+	x := New(&err, []float64{1, 2}, []float64{3, 4}, []float64{5, 7})
+	y := New(&err, []float64{1}, []float64{2}, []float64{4})
+	w := New(&err, []float64{0.5}, []float64{-0.25})
+	assert.NilError(t, err)
+
+	square := func(v float64) float64 { return v * v }
+	dsquare := func(v float64) float64 { return 2 * v }
+
+	tape := NewTape()
+	X := tape.Variable(&err, x)
+	Y := tape.Variable(&err, y)
+	W := tape.Variable(&err, w)
+	loss := X.Multiply(&err, W).Subtract(&err, Y).Apply(&err, square, dsquare).Sum(&err)
+	loss.Backward(&err)
+	assert.NilError(t, err)
+
+	// dL/dW = 2 Xᵀ (XW - Y)
+	r := x.Transpose(&err).Multiply(&err, x.Multiply(&err, w).Subtract(&err, y)).MultiplyScalar(&err, 2)
+	assert.NilError(t, err)
+	assert.Check(t, W.Gradient.ApproxEqual(r, 1e-12))
+	assert.Check(t, loss.Gradient.Equal(New(&err, []float64{1})))
+
+	f := func(v Matrix[float64]) float64 {
+		d := x.Multiply(&err, v).Subtract(&err, y)
+		return d.Transpose(&err).Multiply(&err, d).Values[0][0]
+	}
+	assert.Check(t, W.Gradient.ApproxEqual(numericalGradient(t, f, w), 1e-6))
+
+	// Gradient descent reduces the loss.
+	for k := 0; k < 100; k++ {
+		tape := NewTape()
+		W := tape.Variable(&err, w)
+		loss := tape.Variable(&err, x).Multiply(&err, W).Subtract(&err, tape.Variable(&err, y)).Apply(&err, square, dsquare).Sum(&err)
+		loss.Backward(&err)
+		w = w.Subtract(&err, W.Gradient.MultiplyScalar(&err, 0.005))
+	}
+	assert.NilError(t, err)
+	assert.Check(t, f(w) < 0.2, "loss %v", f(w))
+}
+
+func TestTapeOperations(t *testing.T) {
+	var err error
+
+	a := New(&err, []float64{2, 1}, []float64{1, 3})
+	b := New(&err, []float64{0.5, -1}, []float64{2, 0.25})
+	assert.NilError(t, err)
+
+	// L = sum(tanh(3 (A B⁻¹)ᵀ + A - B))
+	expression := func(A, B *Node) *Node {
+		return A.Multiply(&err, B.Inverse(&err)).Transpose(&err).MultiplyScalar(&err, 3).
+			Add(&err, A).Subtract(&err, B).
+			Apply(&err, math.Tanh, func(v float64) float64 { return 1 - math.Pow(math.Tanh(v), 2) })
+	}
+
+	tape := NewTape()
+	A := tape.Variable(&err, a)
+	B := tape.Variable(&err, b)
+	out := expression(A, B)
+	out.Backward(&err)
+	assert.NilError(t, err)
+
+	f := func(x, y Matrix[float64]) float64 {
+		tape := NewTape()
+		return expression(tape.Variable(&err, x), tape.Variable(&err, y)).Sum(&err).Value.Values[0][0]
+	}
+	ga := numericalGradient(t, func(x Matrix[float64]) float64 { return f(x, b) }, a)
+	gb := numericalGradient(t, func(y Matrix[float64]) float64 { return f(a, y) }, b)
+	assert.NilError(t, err)
+	assert.Check(t, A.Gradient.ApproxEqual(ga, 1e-6))
+	assert.Check(t, B.Gradient.ApproxEqual(gb, 1e-6))
+
+	// Backward can be called again from an intermediate node.
+	s := A.Transpose(&err)
+	s.Backward(&err)
+	assert.NilError(t, err)
+	assert.Check(t, A.Gradient.Equal(New(&err, []float64{1, 1}, []float64{1, 1})))
+	assert.Check(t, B.Gradient.Equal(NewZero[float64](&err, b.Dimensions)))
+}
+
+func TestTapeErrors(t *testing.T) {
+	var err error
+
+	tape := NewTape()
+	a := tape.Variable(&err, New(&err, []float64{1, 2}))
+	b := tape.Variable(&err, New(&err, []float64{1, 2}))
+	assert.NilError(t, err)
+
+	_ = a.Multiply(&err, b)
+	assert.ErrorContains(t, err, "cannot multiply matrices due to incompatible dimensions")
+	err = nil
+
+	c := NewTape().Variable(&err, New(&err, []float64{1, 2}))
+	assert.NilError(t, err)
+	_ = a.Add(&err, c)
+	assert.ErrorContains(t, err, "cannot combine nodes from different tapes")
+	err = nil
+
+	s := tape.Variable(&err, New(&err, []float64{1, 2}, []float64{2, 4}))
+	assert.NilError(t, err)
+	n := s.Inverse(&err).Transpose(&err)
+	assert.ErrorContains(t, err, "cannot invert, matrix is singular")
+	assert.Check(t, n == nil)
+}